@@ -0,0 +1,141 @@
+package srkeyring
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// palletTypeID is the type id prefix of PalletId module accounts
+	palletTypeID = "modl"
+	// parachainTypeID is the type id prefix of a parachain's sovereign
+	// account on the relay chain
+	parachainTypeID = "para"
+	// siblingTypeID is the type id prefix of a parachain's sovereign account
+	// on a sibling parachain
+	siblingTypeID = "sibl"
+
+	// palletIDLength is the length of a PalletId
+	palletIDLength = 8
+)
+
+var (
+	ErrInvalidPalletID = errors.New("Pallet id must be 8 bytes long")
+)
+
+// truncatingAccountID returns the account id of the concatenated type id and
+// SCALE encoded parts, padded with zeros or truncated to 32 bytes.
+// See https://github.com/paritytech/substrate/blob/master/primitives/runtime/src/traits.rs
+// function into_account_truncating()
+func truncatingAccountID(typeID string, parts ...[]byte) [32]byte {
+	var acc [32]byte
+
+	buf := []byte(typeID)
+
+	for _, p := range parts {
+		buf = append(buf, p...)
+	}
+
+	copy(acc[:], buf)
+
+	return acc
+}
+
+// PalletAccountID returns the module account id of the 8 byte PalletId, eg:
+// "py/trsry" for the treasury
+func PalletAccountID(id string) ([32]byte, error) {
+
+	if len(id) != palletIDLength {
+		return [32]byte{}, ErrInvalidPalletID
+	}
+
+	return truncatingAccountID(palletTypeID, []byte(id)), nil
+}
+
+// PalletSubAccountID returns the sub account id of the PalletId module
+// account, where sub is the SCALE encoded sub account seed such as a bounty
+// index.  Account ids exceeding 32 bytes are truncated
+func PalletSubAccountID(id string, sub []byte) ([32]byte, error) {
+
+	if len(id) != palletIDLength {
+		return [32]byte{}, ErrInvalidPalletID
+	}
+
+	return truncatingAccountID(palletTypeID, []byte(id), sub), nil
+}
+
+// ParachainAccountID returns the sovereign account id of the parachain on
+// the relay chain
+func ParachainAccountID(paraID uint32) [32]byte {
+	return truncatingAccountID(parachainTypeID, encodeU32(paraID))
+}
+
+// SiblingAccountID returns the sovereign account id of the parachain on its
+// sibling parachains
+func SiblingAccountID(paraID uint32) [32]byte {
+	return truncatingAccountID(siblingTypeID, encodeU32(paraID))
+}
+
+// DerivativeAccountID returns the account id of the utility.as_derivative
+// sub account of the account at the given index, which is the blake2b 256
+// hash of the prefix "modlpy/utilisuba", the account, and the little endian
+// index.
+// See https://github.com/paritytech/substrate/blob/master/frame/utility/src/lib.rs
+// function derivative_account_id()
+func DerivativeAccountID(who [32]byte, index uint16) [32]byte {
+
+	buf := append([]byte(utilityPrefix), who[:]...)
+
+	var i [2]byte
+	binary.LittleEndian.PutUint16(i[:], index)
+	buf = append(buf, i[:]...)
+
+	return blake2b.Sum256(buf)
+}
+
+// PalletAddress returns the SS58 address of the PalletId module account on
+// the network
+func PalletAddress(id string, net Network) (string, error) {
+
+	acc, err := PalletAccountID(id)
+
+	if err != nil {
+		return "", err
+	}
+
+	return SS58Address(acc, net, SS58Checksum)
+}
+
+// ParachainAddress returns the SS58 address of the parachain's sovereign
+// account on the relay chain network
+func ParachainAddress(paraID uint32, net Network) (string, error) {
+	return SS58Address(ParachainAccountID(paraID), net, SS58Checksum)
+}
+
+// SiblingAddress returns the SS58 address of the parachain's sovereign
+// account on the sibling parachain network
+func SiblingAddress(paraID uint32, net Network) (string, error) {
+	return SS58Address(SiblingAccountID(paraID), net, SS58Checksum)
+}
+
+// DerivativeAddress returns the SS58 address of the utility.as_derivative
+// sub account of the SS58 address at the given index, encoded for the network
+func DerivativeAddress(addr string, index uint16, net Network) (string, error) {
+
+	who, _, err := DecodeAnySS58Address(addr, SS58Checksum)
+
+	if err != nil {
+		return "", err
+	}
+
+	return SS58Address(DerivativeAccountID(who, index), net, SS58Checksum)
+}
+
+// encodeU32 returns the SCALE encoding of the unsigned 32 bit integer
+func encodeU32(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, v)
+	return b
+}
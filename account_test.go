@@ -0,0 +1,140 @@
+package srkeyring
+
+import (
+	"testing"
+)
+
+func TestDerivedAccounts(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		address  func() (string, error)
+		expected string
+	}{
+		{
+			name: "Substrate Treasury",
+			address: func() (string, error) {
+				return PalletAddress("py/trsry", NetSubstrate{})
+			},
+			expected: "5EYCAe5ijiYfyeZ2JJCGq56LmPyNRAKzpG4QkoQkkQNB5e6Z",
+		},
+		{
+			name: "Polkadot Treasury",
+			address: func() (string, error) {
+				return PalletAddress("py/trsry", netWide{prefix: 0})
+			},
+			expected: "13UVJyLnbVp9RBZYFwFGyDvVd1y27Tt8tkntv6Q7JVPhFsTB",
+		},
+		{
+			name: "Polkadot Parachain 2000",
+			address: func() (string, error) {
+				return ParachainAddress(2000, netWide{prefix: 0})
+			},
+			expected: "13YMK2eYoAvStnzReuxBjMrAvPXmmdsURwZvc62PrdXimbNy",
+		},
+		{
+			name: "Polkadot Parachain 1000",
+			address: func() (string, error) {
+				return ParachainAddress(1000, netWide{prefix: 0})
+			},
+			expected: "13YMK2edbuhwMBxeUWm9c643A2wyYHwSVh1bCM7tShtg7Dtk",
+		},
+		{
+			name: "Sibling 1000",
+			address: func() (string, error) {
+				return SiblingAddress(1000, NetSubstrate{})
+			},
+			expected: "5Eg2fntNprdN3FgH4sfEaaZhYtddZQSQUqvYJ1f2mLtinVhV",
+		},
+		{
+			name: "Alice Derivative 0",
+			address: func() (string, error) {
+				return DerivativeAddress(aliceAddress, 0, NetSubstrate{})
+			},
+			expected: "5Ep769A4Ka6QrHYoPfzA1fTWRSXpf28vhdbWHWmkWmi4SNHi",
+		},
+		{
+			name: "Alice Derivative 1",
+			address: func() (string, error) {
+				return DerivativeAddress(aliceAddress, 1, NetSubstrate{})
+			},
+			expected: "5HfyUeY7jWfArT21FcynErXqZUDBgHirZsSkZsQVje9Ner6m",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			addr, err := tt.address()
+
+			if err != nil {
+				t.Fatalf("Error getting SS58 Address: %v", err)
+			}
+
+			if addr != tt.expected {
+				t.Errorf("Invalid address, expected %v, got %v", tt.expected, addr)
+			}
+		})
+	}
+}
+
+func TestTruncatingAccountID(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		typeID   string
+		parts    [][]byte
+		expected string
+	}{
+		{
+			name:     "Parachain",
+			typeID:   parachainTypeID,
+			parts:    [][]byte{encodeU32(2000)},
+			expected: "0x70617261d0070000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name:     "Pallet Sub Account",
+			typeID:   palletTypeID,
+			parts:    [][]byte{[]byte("py/trsry"), []byte("bt"), encodeU32(1)},
+			expected: "0x6d6f646c70792f74727372796274010000000000000000000000000000000000",
+		},
+		{
+			name:     "Truncated",
+			typeID:   palletTypeID,
+			parts:    [][]byte{[]byte("py/trsry"), make([]byte, 24), {0xff}},
+			expected: "0x6d6f646c70792f74727372790000000000000000000000000000000000000000",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			acc := truncatingAccountID(tt.typeID, tt.parts...)
+
+			if EncodeHex(acc[:], "0x") != tt.expected {
+				t.Errorf("Invalid account id, expected %v, got %x", tt.expected, acc)
+			}
+		})
+	}
+}
+
+func TestPalletAccountIDInvalid(t *testing.T) {
+
+	for _, id := range []string{"", "py/trs", "py/trsry1"} {
+		if _, err := PalletAccountID(id); err != ErrInvalidPalletID {
+			t.Errorf("Invalid error for %q, expected %v, got %v", id, ErrInvalidPalletID, err)
+		}
+
+		if _, err := PalletSubAccountID(id, nil); err != ErrInvalidPalletID {
+			t.Errorf("Invalid error for %q, expected %v, got %v", id, ErrInvalidPalletID, err)
+		}
+	}
+
+	if _, err := DerivativeAddress("invalid", 0, NetSubstrate{}); err == nil {
+		t.Errorf("Expected error for invalid address")
+	}
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"errors"
+	"io"
+
+	"github.com/swdee/srkeyring"
+	"github.com/swdee/srkeyring/registry"
+)
+
+var errInvalidPublicKey = errors.New("public key is not valid hex of the scheme's key length")
+
+// generateCmd generates a random mnemonic and outputs the key details
+func generateCmd(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+
+	var opts keyOptions
+	var words int
+	var language string
+
+	fs := newFlagSet("generate", stderr)
+	opts.register(fs)
+	fs.IntVar(&words, "words", 12, "number of mnemonic words, 12, 15, 18, 21, or 24")
+	fs.IntVar(&words, "w", 12, "alias of --words")
+	fs.StringVar(&language, "language", "english", "mnemonic wordlist language")
+
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	lang, err := srkeyring.ParseLanguage(language)
+
+	if err != nil {
+		return err
+	}
+
+	net, _ := opts.net()
+	scheme, _ := opts.keyScheme()
+
+	kr, err := srkeyring.GenerateWithLanguage(words, lang, net)
+
+	if err != nil {
+		return err
+	}
+
+	mnemonic, err := kr.Mnemonic()
+
+	if err != nil {
+		return err
+	}
+
+	kp, err := srkeyring.FromURIWithScheme(opts.withPassword(mnemonic), net, scheme)
+
+	if err != nil {
+		return err
+	}
+
+	info, err := describeKey(kp, phraseURI, mnemonic, "", net)
+
+	if err != nil {
+		return err
+	}
+
+	return printKey(stdout, info, opts.outputType)
+}
+
+// inspectCmd outputs the key details of a Secret URI or public key
+func inspectCmd(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+
+	var opts keyOptions
+	var public bool
+
+	fs := newFlagSet("inspect", stderr)
+	opts.register(fs)
+	fs.BoolVar(&public, "public", false, "treat the URI as a hex encoded public key")
+
+	pos, err := parseFlags(fs, args)
+
+	if err != nil {
+		return err
+	}
+
+	if len(pos) != 1 {
+		return errMissingArg
+	}
+
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	var info *keyInfo
+
+	if public {
+		info, err = inspectPublic(pos[0], &opts)
+	} else {
+		info, err = inspectURI(pos[0], &opts)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return printKey(stdout, info, opts.outputType)
+}
+
+// inspectPublic returns the key details of the hex encoded public key
+func inspectPublic(uri string, opts *keyOptions) (*keyInfo, error) {
+
+	net, _ := opts.net()
+	scheme, _ := opts.keyScheme()
+
+	kp, err := publicKeyPair(uri, net, scheme)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return describeKey(kp, publicURI, uri, "", net)
+}
+
+// publicKeyPair returns the key pair of the hex encoded public key
+func publicKeyPair(str string, net srkeyring.Network, scheme srkeyring.Scheme) (
+	srkeyring.KeyPair, error) {
+
+	b, ok := srkeyring.DecodeHex(str, "0x")
+
+	if !ok {
+		return nil, errInvalidPublicKey
+	}
+
+	var pub [32]byte
+
+	if scheme != srkeyring.Ecdsa {
+		if len(b) != len(pub) {
+			return nil, errInvalidPublicKey
+		}
+
+		copy(pub[:], b)
+	}
+
+	switch scheme {
+	case srkeyring.Sr25519:
+		return srkeyring.FromPublic(pub, net)
+
+	case srkeyring.Ed25519:
+		return srkeyring.Ed25519FromPublic(pub, net)
+
+	default:
+		var ecPub [srkeyring.EcdsaPublicKeyLength]byte
+
+		if len(b) != len(ecPub) {
+			return nil, errInvalidPublicKey
+		}
+
+		copy(ecPub[:], b)
+
+		return srkeyring.EcdsaFromPublic(ecPub, net)
+	}
+}
+
+// inspectURI returns the key details of the Secret URI
+func inspectURI(uri string, opts *keyOptions) (*keyInfo, error) {
+
+	net, _ := opts.net()
+	scheme, _ := opts.keyScheme()
+
+	orig, err := srkeyring.NewSecretURI(expandURI(uri), net)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if raw, prefix, err := srkeyring.DecodeAnySS58Address(orig.Phrase, srkeyring.SS58Checksum); err == nil {
+		// ss58 address, output in the address network unless one was given
+		if opts.network == "" {
+			if n, err := registry.ByPrefix(prefix); err == nil {
+				net = n
+			}
+		}
+
+		ss58, err := srkeyring.SS58Address(raw, net, srkeyring.SS58Checksum)
+
+		if err != nil {
+			return nil, err
+		}
+
+		kp, err := srkeyring.FromURIWithScheme(ss58+orig.Path, net, scheme)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return describeKey(kp, publicURI, uri, orig.Path, net)
+	}
+
+	kp, err := srkeyring.FromURIWithScheme(opts.withPassword(expandURI(uri)), net, scheme)
+
+	if err != nil {
+		return nil, err
+	}
+
+	kind := secretURI
+
+	if _, isHex := srkeyring.DecodeHex(orig.Phrase, "0x"); orig.Path == "" &&
+		orig.Password == "" && !isHex {
+		kind = phraseURI
+	}
+
+	return describeKey(kp, kind, uri, orig.Path, net)
+}
@@ -0,0 +1,87 @@
+// Command srkey is a command line utility compatible with Substrate's subkey
+// for generating, inspecting, signing, and verifying with sr25519, ed25519,
+// and ecdsa keys.
+//
+// Usage:
+//
+//	srkey generate [--words 12] [--network substrate] [--scheme sr25519] [--password pass] [--output-type text|json]
+//	srkey inspect <uri> [--public] [--network substrate] [--scheme sr25519] [--password pass] [--output-type text|json]
+//	srkey sign --suri <uri> [--message msg] [--hex] [--scheme sr25519] [--password pass]
+//	srkey verify <signature> <uri> [--message msg] [--hex] [--scheme sr25519]
+//	srkey vanity --pattern <pattern> [--seed-only] [--network substrate] [--scheme sr25519] [--output-type text|json]
+//	srkey generate-node-key [--file path] [--bin]
+//
+// When --message is not given the message is read from stdin.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// command is a srkey sub command
+type command struct {
+	name string
+	desc string
+	run  func(args []string, stdin io.Reader, stdout, stderr io.Writer) error
+}
+
+// commands are the available sub commands
+var commands = []command{
+	{"generate", "Generate a random account", generateCmd},
+	{"inspect", "Inspect a key given a Secret URI or public key", inspectCmd},
+	{"sign", "Sign a message with a given (secret) key", signCmd},
+	{"verify", "Verify a signature for a message", verifyCmd},
+	{"vanity", "Generate a mnemonic that provides a vanity address", vanityCmd},
+	{"generate-node-key", "Generate a random node libp2p key", generateNodeKeyCmd},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the sub command given by args and returns the exit code
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+
+	switch args[0] {
+	case "help", "-h", "--help":
+		usage(stdout)
+		return 0
+	}
+
+	for _, cmd := range commands {
+
+		if cmd.name != args[0] {
+			continue
+		}
+
+		if err := cmd.run(args[1:], stdin, stdout, stderr); err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return 1
+		}
+
+		return 0
+	}
+
+	fmt.Fprintf(stderr, "Error: unknown command %q\n", args[0])
+	usage(stderr)
+
+	return 2
+}
+
+// usage prints the list of sub commands
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: srkey <command> [options]\n\nCommands:\n")
+
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-19s %s\n", cmd.name, cmd.desc)
+	}
+
+	fmt.Fprintf(w, "\nRun 'srkey <command> --help' for command options\n")
+}
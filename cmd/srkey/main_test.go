@@ -0,0 +1,490 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Note: expected output matches the format and values of subkey inspect for
+// the Substrate dev accounts
+
+func TestInspect(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		args   []string
+		output string
+	}{
+		{
+			name: "Sr25519 Alice",
+			args: []string{"inspect", "//Alice"},
+			output: "Secret Key URI `//Alice` is account:\n" +
+				"  Network ID:        substrate\n" +
+				"  Secret seed:       0xe5be9a5092b81bca64be81d212e7f2f9eba183bb7a90954f7b76361f6edb5c0a\n" +
+				"  Public key (hex):  0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d\n" +
+				"  Account ID:        0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d\n" +
+				"  Public key (SS58): 5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\n" +
+				"  SS58 Address:      5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\n",
+		},
+		{
+			name: "Sr25519 Alice Polkadot",
+			args: []string{"inspect", "--network", "polkadot", "//Alice"},
+			output: "Secret Key URI `//Alice` is account:\n" +
+				"  Network ID:        polkadot\n" +
+				"  Secret seed:       0xe5be9a5092b81bca64be81d212e7f2f9eba183bb7a90954f7b76361f6edb5c0a\n" +
+				"  Public key (hex):  0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d\n" +
+				"  Account ID:        0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d\n" +
+				"  Public key (SS58): 15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5\n" +
+				"  SS58 Address:      15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5\n",
+		},
+		{
+			name: "Sr25519 Alice Mixed Case Network",
+			args: []string{"inspect", "--network", "kico", "//Alice"},
+			output: "Secret Key URI `//Alice` is account:\n" +
+				"  Network ID:        KICO\n" +
+				"  Secret seed:       0xe5be9a5092b81bca64be81d212e7f2f9eba183bb7a90954f7b76361f6edb5c0a\n" +
+				"  Public key (hex):  0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d\n" +
+				"  Account ID:        0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d\n" +
+				"  Public key (SS58): 6GjYWVeGvuGragJdDBQebEAgXxdpnFb2G4EqME3PRqDCj91w\n" +
+				"  SS58 Address:      6GjYWVeGvuGragJdDBQebEAgXxdpnFb2G4EqME3PRqDCj91w\n",
+		},
+		{
+			name: "Sr25519 Alice Vara Network",
+			args: []string{"inspect", "--network", "vara", "//Alice"},
+			output: "Secret Key URI `//Alice` is account:\n" +
+				"  Network ID:        vara\n" +
+				"  Secret seed:       0xe5be9a5092b81bca64be81d212e7f2f9eba183bb7a90954f7b76361f6edb5c0a\n" +
+				"  Public key (hex):  0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d\n" +
+				"  Account ID:        0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d\n" +
+				"  Public key (SS58): kGkLEU3e3XXkJp2WK4eNpVmSab5xUNL9QtmLPh8QfCL2EgotW\n" +
+				"  SS58 Address:      kGkLEU3e3XXkJp2WK4eNpVmSab5xUNL9QtmLPh8QfCL2EgotW\n",
+		},
+		{
+			name: "Ed25519 Alice",
+			args: []string{"inspect", "//Alice", "--scheme", "ed25519"},
+			output: "Secret Key URI `//Alice` is account:\n" +
+				"  Network ID:        substrate\n" +
+				"  Secret seed:       0xabf8e5bdbe30c65656c0a3cbd181ff8a56294a69dfedd27982aace4a76909115\n" +
+				"  Public key (hex):  0x88dc3417d5058ec4b4503e0c12ea1a0a89be200fe98922423d4334014fa6b0ee\n" +
+				"  Account ID:        0x88dc3417d5058ec4b4503e0c12ea1a0a89be200fe98922423d4334014fa6b0ee\n" +
+				"  Public key (SS58): 5FA9nQDVg267DEd8m1ZypXLBnvN7SFxYwV7ndqSYGiN9TTpu\n" +
+				"  SS58 Address:      5FA9nQDVg267DEd8m1ZypXLBnvN7SFxYwV7ndqSYGiN9TTpu\n",
+		},
+		{
+			name: "Ecdsa Alice",
+			args: []string{"inspect", "//Alice", "--scheme", "ecdsa"},
+			output: "Secret Key URI `//Alice` is account:\n" +
+				"  Network ID:        substrate\n" +
+				"  Secret seed:       0xcb6df9de1efca7a3998a8ead4e02159d5fa99c3e0d4fd6432667390bb4726854\n" +
+				"  Public key (hex):  0x020a1091341fe5664bfa1782d5e04779689068c916b04cb365ec3153755684d9a1\n" +
+				"  Account ID:        0x01e552298e47454041ea31273b4b630c64c104e4514aa3643490b8aaca9cf8ed\n" +
+				"  Public key (SS58): KW39r9CJjAVzmkf9zQ4YDb2hqfAVGdRqn53eRqyruqpxAP5YL\n" +
+				"  SS58 Address:      5C7C2Z5sWbytvHpuLTvzKunnnRwQxft1jiqrLD5rhucQ5S9X\n",
+		},
+		{
+			name: "Secret Phrase",
+			args: []string{"inspect", "bottom drive obey lake curtain smoke basket hold race lonely fit walk"},
+			output: "Secret phrase:       bottom drive obey lake curtain smoke basket hold race lonely fit walk\n" +
+				"  Network ID:        substrate\n" +
+				"  Secret seed:       0xfac7959dbfe72f052e5a0c3c8d6530f202b02fd8f9f5ca3580ec8deb7797479e\n" +
+				"  Public key (hex):  0x46ebddef8cd9bb167dc30878d7113b7e168e6f0646beffd77d69d39bad76b47a\n" +
+				"  Account ID:        0x46ebddef8cd9bb167dc30878d7113b7e168e6f0646beffd77d69d39bad76b47a\n" +
+				"  Public key (SS58): 5DfhGyQdFobKM8NsWvEeAKk5EQQgYe9AydgJ7rMB6E1EqRzV\n" +
+				"  SS58 Address:      5DfhGyQdFobKM8NsWvEeAKk5EQQgYe9AydgJ7rMB6E1EqRzV\n",
+		},
+		{
+			name: "Soft Derivation",
+			args: []string{"inspect", "//Alice/0"},
+			output: "Secret Key URI `//Alice/0` is account:\n" +
+				"  Network ID:        substrate\n" +
+				"  Secret seed:       n/a\n" +
+				"  Public key (hex):  0x9057db4878163172ea51d570612043a98971737bf608b544991130ac110b0801\n" +
+				"  Account ID:        0x9057db4878163172ea51d570612043a98971737bf608b544991130ac110b0801\n" +
+				"  Public key (SS58): 5FKxrLQM24ZhLxcaQfJR3uMxMZh5gU6E4CP3yghPJLzCDnHN\n" +
+				"  SS58 Address:      5FKxrLQM24ZhLxcaQfJR3uMxMZh5gU6E4CP3yghPJLzCDnHN\n",
+		},
+		{
+			name: "Public SS58",
+			args: []string{"inspect", "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5"},
+			output: "Public Key URI `15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5` is account:\n" +
+				"  Network ID/Version: polkadot\n" +
+				"  Public key (hex):   0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d\n" +
+				"  Account ID:         0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d\n" +
+				"  Public key (SS58):  15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5\n" +
+				"  SS58 Address:       15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5\n",
+		},
+		{
+			name: "Public Hex",
+			args: []string{"inspect", "--public", "0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"},
+			output: "Public Key URI `0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d` is account:\n" +
+				"  Network ID/Version: substrate\n" +
+				"  Public key (hex):   0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d\n" +
+				"  Account ID:         0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d\n" +
+				"  Public key (SS58):  5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\n" +
+				"  SS58 Address:       5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\n",
+		},
+		{
+			name: "JSON Output",
+			args: []string{"inspect", "//Alice", "--output-type", "json"},
+			output: "{\n" +
+				"  \"accountId\": \"0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d\",\n" +
+				"  \"networkId\": \"substrate\",\n" +
+				"  \"publicKey\": \"0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d\",\n" +
+				"  \"secretKeyUri\": \"//Alice\",\n" +
+				"  \"secretSeed\": \"0xe5be9a5092b81bca64be81d212e7f2f9eba183bb7a90954f7b76361f6edb5c0a\",\n" +
+				"  \"ss58Address\": \"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\",\n" +
+				"  \"ss58PublicKey\": \"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY\"\n" +
+				"}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout, stderr bytes.Buffer
+
+			if code := run(tt.args, nil, &stdout, &stderr); code != 0 {
+				t.Fatalf("Invalid exit code %d: %s", code, stderr.String())
+			}
+
+			if stdout.String() != tt.output {
+				t.Errorf("Invalid output, expected\n%s\ngot\n%s", tt.output, stdout.String())
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		words    int
+		language string
+		opts     []string
+	}{
+		{
+			name:  "Default",
+			words: 12,
+		},
+		{
+			name:     "Japanese",
+			words:    15,
+			language: "japanese",
+		},
+		{
+			name:     "Spanish Ed25519",
+			words:    12,
+			language: "spanish",
+			opts:     []string{"--scheme", "ed25519"},
+		},
+		{
+			name:  "24 Words Ed25519",
+			words: 24,
+			opts:  []string{"--scheme", "ed25519"},
+		},
+		{
+			name:  "Ecdsa with Password",
+			words: 12,
+			opts:  []string{"--scheme", "ecdsa", "--password", "pass1234"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout, stderr bytes.Buffer
+			args := []string{"generate", "--words", strconv.Itoa(tt.words), "--output-type", "json"}
+			args = append(args, tt.opts...)
+
+			if tt.language != "" {
+				args = append(args, "--language", tt.language)
+			}
+
+			if code := run(args, nil, &stdout, &stderr); code != 0 {
+				t.Fatalf("Invalid exit code %d: %s", code, stderr.String())
+			}
+
+			var out map[string]string
+
+			if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+				t.Fatalf("Error decoding output: %v", err)
+			}
+
+			if n := len(strings.Fields(out["secretPhrase"])); n != tt.words {
+				t.Errorf("Invalid word count, expected %d, got %d", tt.words, n)
+			}
+
+			// the generated phrase inspects to the same account
+			inspect := []string{"inspect", out["secretPhrase"], "--output-type", "json"}
+			inspect = append(inspect, tt.opts...)
+			stdout.Reset()
+
+			if code := run(inspect, nil, &stdout, &stderr); code != 0 {
+				t.Fatalf("Invalid exit code %d: %s", code, stderr.String())
+			}
+
+			var out2 map[string]string
+
+			if err := json.Unmarshal(stdout.Bytes(), &out2); err != nil {
+				t.Fatalf("Error decoding output: %v", err)
+			}
+
+			if out["ss58Address"] != out2["ss58Address"] {
+				t.Errorf("Invalid address, expected %v, got %v", out["ss58Address"], out2["ss58Address"])
+			}
+		})
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		scheme string
+		public string
+	}{
+		{
+			name:   "Sr25519",
+			scheme: "sr25519",
+			public: "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+		},
+		{
+			name:   "Ed25519",
+			scheme: "ed25519",
+			public: "0x88dc3417d5058ec4b4503e0c12ea1a0a89be200fe98922423d4334014fa6b0ee",
+		},
+		{
+			name:   "Ecdsa",
+			scheme: "ecdsa",
+			public: "0x020a1091341fe5664bfa1782d5e04779689068c916b04cb365ec3153755684d9a1",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout, stderr bytes.Buffer
+
+			args := []string{"sign", "--suri", "//Alice", "--scheme", tt.scheme}
+
+			if code := run(args, strings.NewReader("test message"), &stdout, &stderr); code != 0 {
+				t.Fatalf("Invalid exit code %d: %s", code, stderr.String())
+			}
+
+			sig := strings.TrimSpace(stdout.String())
+			stdout.Reset()
+
+			args = []string{"verify", sig, tt.public, "--scheme", tt.scheme}
+
+			if code := run(args, strings.NewReader("test message"), &stdout, &stderr); code != 0 {
+				t.Fatalf("Invalid exit code %d: %s", code, stderr.String())
+			}
+
+			if stdout.String() != "Signature verifies correctly.\n" {
+				t.Errorf("Invalid output, got %v", stdout.String())
+			}
+
+			// hex encoded message of "other message"
+			args = []string{"verify", sig, tt.public, "--scheme", tt.scheme,
+				"--hex", "--message", hex.EncodeToString([]byte("other message"))}
+
+			if code := run(args, nil, &stdout, &stderr); code != 1 {
+				t.Errorf("Invalid exit code, expected 1, got %d", code)
+			}
+		})
+	}
+}
+
+func TestVanity(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		args     []string
+		seedOnly bool
+	}{
+		{
+			name: "Mnemonic",
+			args: []string{"vanity", "--pattern", "a", "--output-type", "json"},
+		},
+		{
+			name:     "Seed Only",
+			args:     []string{"vanity", "--pattern", "a", "--seed-only", "--output-type", "json"},
+			seedOnly: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout, stderr bytes.Buffer
+
+			if code := run(tt.args, nil, &stdout, &stderr); code != 0 {
+				t.Fatalf("Invalid exit code %d: %s", code, stderr.String())
+			}
+
+			var out map[string]string
+
+			if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+				t.Fatalf("Error decoding output: %v", err)
+			}
+
+			if !strings.Contains(out["ss58Address"], "a") {
+				t.Errorf("Address %v does not contain pattern", out["ss58Address"])
+			}
+
+			if tt.seedOnly {
+				if out["secretKeyUri"] != out["secretSeed"] {
+					t.Errorf("Invalid secret seed, expected %v, got %v", out["secretKeyUri"], out["secretSeed"])
+				}
+
+				return
+			}
+
+			if n := len(strings.Fields(out["secretPhrase"])); n != 12 {
+				t.Errorf("Invalid secret phrase, expected 12 words, got %d", n)
+			}
+		})
+	}
+}
+
+func TestPeerID(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		secret string
+		peerID string
+	}{
+		{
+			name:   "Alice Node Key",
+			secret: "0000000000000000000000000000000000000000000000000000000000000001",
+			peerID: "12D3KooWEyoppNCUx8Yx66oV9fJnriXwCcXwDDUA2kj6vnc6iDEp",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			seed, err := hex.DecodeString(tt.secret)
+
+			if err != nil {
+				t.Fatalf("Invalid hex decode: %v", err)
+			}
+
+			pub := ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey)
+
+			if id := peerID(pub); id != tt.peerID {
+				t.Errorf("Invalid PeerId, expected %v, got %v", tt.peerID, id)
+			}
+		})
+	}
+}
+
+func TestGenerateNodeKey(t *testing.T) {
+
+	var stdout, stderr bytes.Buffer
+
+	if code := run([]string{"generate-node-key"}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("Invalid exit code %d: %s", code, stderr.String())
+	}
+
+	seed, err := hex.DecodeString(stdout.String())
+
+	if err != nil || len(seed) != ed25519.SeedSize {
+		t.Fatalf("Invalid secret key %v", stdout.String())
+	}
+
+	pub := ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey)
+
+	if strings.TrimSpace(stderr.String()) != peerID(pub) {
+		t.Errorf("Invalid PeerId, expected %v, got %v", peerID(pub), stderr.String())
+	}
+}
+
+func TestRunInvalid(t *testing.T) {
+
+	tests := []struct {
+		name string
+		args []string
+		code int
+	}{
+		{
+			name: "No Command",
+			args: []string{},
+			code: 2,
+		},
+		{
+			name: "Unknown Command",
+			args: []string{"unknown"},
+			code: 2,
+		},
+		{
+			name: "Unknown Network",
+			args: []string{"inspect", "//Alice", "--network", "unknown"},
+			code: 1,
+		},
+		{
+			name: "Unknown Scheme",
+			args: []string{"inspect", "//Alice", "--scheme", "rsa"},
+			code: 1,
+		},
+		{
+			name: "Invalid Output Type",
+			args: []string{"generate", "--output-type", "xml"},
+			code: 1,
+		},
+		{
+			name: "Unknown Language",
+			args: []string{"generate", "--language", "klingon"},
+			code: 1,
+		},
+		{
+			name: "Missing URI",
+			args: []string{"inspect"},
+			code: 1,
+		},
+		{
+			name: "Missing Pattern",
+			args: []string{"vanity"},
+			code: 1,
+		},
+		{
+			name: "Missing SURI",
+			args: []string{"sign", "--message", "test"},
+			code: 1,
+		},
+		{
+			name: "Ecdsa Verify SS58",
+			args: []string{"verify", "00", "5C7C2Z5sWbytvHpuLTvzKunnnRwQxft1jiqrLD5rhucQ5S9X",
+				"--scheme", "ecdsa", "--message", "test"},
+			code: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout, stderr bytes.Buffer
+
+			if code := run(tt.args, nil, &stdout, &stderr); code != tt.code {
+				t.Errorf("Invalid exit code, expected %d, got %d", tt.code, code)
+			}
+		})
+	}
+}
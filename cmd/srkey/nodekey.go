@@ -0,0 +1,72 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/decred/base58"
+)
+
+// libp2pKeyEd25519 is the libp2p protobuf KeyType of ed25519 keys
+const libp2pKeyEd25519 = 1
+
+// generateNodeKeyCmd generates a random ed25519 libp2p node key, writing the
+// secret to stdout or a file and the PeerId to stderr
+func generateNodeKeyCmd(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+
+	var file string
+	var bin bool
+
+	fs := newFlagSet("generate-node-key", stderr)
+	fs.StringVar(&file, "file", "", "file to write the secret key to instead of stdout")
+	fs.BoolVar(&bin, "bin", false, "write the secret key as raw bytes instead of hex")
+
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	pub, secret, err := ed25519.GenerateKey(rand.Reader)
+
+	if err != nil {
+		return err
+	}
+
+	data := secret.Seed()
+
+	if !bin {
+		data = []byte(hex.EncodeToString(data))
+	}
+
+	if file != "" {
+		err = ioutil.WriteFile(file, data, 0600)
+	} else {
+		_, err = stdout.Write(data)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(stderr, peerID(pub))
+
+	return err
+}
+
+// peerID returns the libp2p PeerId of the ed25519 public key, which is the
+// base58 encoded identity multihash of the protobuf encoded public key
+func peerID(pub ed25519.PublicKey) string {
+
+	// protobuf PublicKey{Type: Ed25519, Data: pub}
+	key := []byte{0x08, libp2pKeyEd25519, 0x12, byte(len(pub))}
+	key = append(key, pub...)
+
+	// identity multihash
+	mh := []byte{0x00, byte(len(key))}
+	mh = append(mh, key...)
+
+	return base58.Encode(mh)
+}
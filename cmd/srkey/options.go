@@ -0,0 +1,162 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/swdee/srkeyring"
+	"github.com/swdee/srkeyring/registry"
+)
+
+const (
+	// devPhrase is the mnemonic used by Substrate when a Secret URI has no
+	// phrase, eg: "//Alice"
+	devPhrase = "bottom drive obey lake curtain smoke basket hold race lonely fit walk"
+
+	// defaultNetwork is the network used when none is given
+	defaultNetwork = "substrate"
+
+	outputText = "text"
+	outputJSON = "json"
+)
+
+var (
+	errOutputType = errors.New("output type must be text or json")
+	errMissingArg = errors.New("missing required argument")
+)
+
+// keyOptions are the flags shared by commands working with keys
+type keyOptions struct {
+	network    string
+	scheme     string
+	password   string
+	outputType string
+}
+
+// register adds the key option flags to the flag set
+func (o *keyOptions) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.network, "network", "", "network name or SS58 prefix, eg: polkadot")
+	fs.StringVar(&o.network, "n", "", "alias of --network")
+	fs.StringVar(&o.scheme, "scheme", "sr25519", "key scheme, sr25519, ed25519, or ecdsa")
+	fs.StringVar(&o.password, "password", "", "password for the Secret URI")
+	fs.StringVar(&o.outputType, "output-type", outputText, "output format, text or json")
+}
+
+// keyScheme returns the parsed scheme option
+func (o *keyOptions) keyScheme() (srkeyring.Scheme, error) {
+	return srkeyring.ParseScheme(o.scheme)
+}
+
+// validate checks the options are valid
+func (o *keyOptions) validate() error {
+
+	if o.outputType != outputText && o.outputType != outputJSON {
+		return errOutputType
+	}
+
+	if _, err := o.keyScheme(); err != nil {
+		return err
+	}
+
+	_, err := o.net()
+
+	return err
+}
+
+// net returns the network given by case-insensitive name or SS58 prefix, or
+// the default network if not set
+func (o *keyOptions) net() (*registry.Network, error) {
+
+	name := o.network
+
+	if name == "" {
+		name = defaultNetwork
+	}
+
+	if prefix, err := strconv.ParseUint(name, 10, 16); err == nil {
+		return registry.ByPrefix(uint16(prefix))
+	}
+
+	return registry.ByNameFold(name)
+}
+
+// withPassword returns the Secret URI with the password option appended
+func (o *keyOptions) withPassword(uri string) string {
+	if o.password == "" {
+		return uri
+	}
+
+	return uri + "///" + o.password
+}
+
+// parseFlags parses the flag set allowing positional arguments to be mixed
+// with flags, eg: "inspect //Alice --scheme ed25519"
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+
+	var pos []string
+
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+
+		if fs.NArg() == 0 {
+			return pos, nil
+		}
+
+		pos = append(pos, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// newFlagSet returns a flag set for the command writing errors to w
+func newFlagSet(name string, w io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("srkey "+name, flag.ContinueOnError)
+	fs.SetOutput(w)
+	return fs
+}
+
+// expandURI returns the Secret URI using the Substrate dev phrase when the
+// URI has no phrase
+func expandURI(uri string) string {
+	if strings.HasPrefix(uri, "/") {
+		return devPhrase + uri
+	}
+
+	return uri
+}
+
+// readMessage returns the message option or reads it from stdin, hex decoding
+// it if required
+func readMessage(msg string, isHex bool, stdin io.Reader) ([]byte, error) {
+
+	var data []byte
+
+	if msg != "" {
+		data = []byte(msg)
+	} else {
+		b, err := ioutil.ReadAll(stdin)
+
+		if err != nil {
+			return nil, err
+		}
+
+		data = b
+	}
+
+	if !isHex {
+		return data, nil
+	}
+
+	b, ok := srkeyring.DecodeHex(strings.TrimSpace(string(data)), "0x")
+
+	if !ok {
+		return nil, errors.New("message is not valid hex")
+	}
+
+	return b, nil
+}
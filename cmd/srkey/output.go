@@ -0,0 +1,173 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/swdee/srkeyring"
+)
+
+// uriKind is the kind of URI a key was created from, which determines the
+// output format
+type uriKind int
+
+const (
+	phraseURI uriKind = iota
+	secretURI
+	publicURI
+)
+
+// seedNotAvailable is output in place of the secret seed when it can not be
+// recovered, such as after soft derivation
+const seedNotAvailable = "n/a"
+
+// softJunctionRe matches a soft junction in a Secret URI path
+var softJunctionRe = regexp.MustCompile(`(^|[^/])/[^/]`)
+
+// keyInfo holds the details of a key as output by subkey
+type keyInfo struct {
+	kind          uriKind
+	uri           string
+	networkID     string
+	secretSeed    string
+	publicKey     string
+	accountID     string
+	ss58PublicKey string
+	ss58Address   string
+}
+
+// describeKey returns the keyInfo of the key pair created from the URI
+func describeKey(kp srkeyring.KeyPair, kind uriKind, uri, path string,
+	net srkeyring.Network) (*keyInfo, error) {
+
+	ss58, err := kp.SS58Address()
+
+	if err != nil {
+		return nil, err
+	}
+
+	acc := kp.AccountID()
+
+	info := &keyInfo{
+		kind:          kind,
+		uri:           uri,
+		networkID:     net.Name(),
+		secretSeed:    seedNotAvailable,
+		publicKey:     "0x" + hex.EncodeToString(kp.PublicKey()),
+		accountID:     "0x" + hex.EncodeToString(acc[:]),
+		ss58PublicKey: ss58,
+		ss58Address:   ss58,
+	}
+
+	if ec, ok := kp.(*srkeyring.EcdsaKeyRing); ok {
+		if info.ss58PublicKey, err = ec.SS58PublicKey(); err != nil {
+			return nil, err
+		}
+	}
+
+	if kind != publicURI && !softJunctionRe.MatchString(path) {
+		if seed, err := keySeed(kp); err == nil {
+			info.secretSeed = "0x" + hex.EncodeToString(seed[:])
+		}
+	}
+
+	return info, nil
+}
+
+// keySeed returns the secret seed of the key pair
+func keySeed(kp srkeyring.KeyPair) ([32]byte, error) {
+	switch k := kp.(type) {
+	case *srkeyring.KeyRing:
+		return k.Seed()
+	case *srkeyring.Ed25519KeyRing:
+		return k.Seed()
+	case *srkeyring.EcdsaKeyRing:
+		return k.Seed()
+	default:
+		return [32]byte{}, srkeyring.ErrSeedNotAvailable
+	}
+}
+
+// printKey writes the key details in the output type format of subkey
+func printKey(w io.Writer, info *keyInfo, outputType string) error {
+
+	if outputType == outputJSON {
+		return printKeyJSON(w, info)
+	}
+
+	var err error
+
+	switch info.kind {
+	case phraseURI:
+		_, err = fmt.Fprintf(w, "Secret phrase:       %s\n"+
+			"  Network ID:        %s\n"+
+			"  Secret seed:       %s\n"+
+			"  Public key (hex):  %s\n"+
+			"  Account ID:        %s\n"+
+			"  Public key (SS58): %s\n"+
+			"  SS58 Address:      %s\n",
+			info.uri, info.networkID, info.secretSeed, info.publicKey,
+			info.accountID, info.ss58PublicKey, info.ss58Address)
+
+	case secretURI:
+		_, err = fmt.Fprintf(w, "Secret Key URI `%s` is account:\n"+
+			"  Network ID:        %s\n"+
+			"  Secret seed:       %s\n"+
+			"  Public key (hex):  %s\n"+
+			"  Account ID:        %s\n"+
+			"  Public key (SS58): %s\n"+
+			"  SS58 Address:      %s\n",
+			info.uri, info.networkID, info.secretSeed, info.publicKey,
+			info.accountID, info.ss58PublicKey, info.ss58Address)
+
+	case publicURI:
+		_, err = fmt.Fprintf(w, "Public Key URI `%s` is account:\n"+
+			"  Network ID/Version: %s\n"+
+			"  Public key (hex):   %s\n"+
+			"  Account ID:         %s\n"+
+			"  Public key (SS58):  %s\n"+
+			"  SS58 Address:       %s\n",
+			info.uri, info.networkID, info.publicKey, info.accountID,
+			info.ss58PublicKey, info.ss58Address)
+	}
+
+	return err
+}
+
+// printKeyJSON writes the key details as JSON with the same fields as subkey
+func printKeyJSON(w io.Writer, info *keyInfo) error {
+
+	out := map[string]string{
+		"networkId":     info.networkID,
+		"publicKey":     info.publicKey,
+		"accountId":     info.accountID,
+		"ss58PublicKey": info.ss58PublicKey,
+		"ss58Address":   info.ss58Address,
+	}
+
+	switch info.kind {
+	case phraseURI:
+		out["secretPhrase"] = info.uri
+		out["secretSeed"] = info.secretSeed
+
+	case secretURI:
+		out["secretKeyUri"] = info.uri
+		out["secretSeed"] = info.secretSeed
+
+	case publicURI:
+		out["publicKeyUri"] = info.uri
+	}
+
+	b, err := json.MarshalIndent(out, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%s\n", b)
+
+	return err
+}
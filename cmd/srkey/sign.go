@@ -0,0 +1,151 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/swdee/srkeyring"
+	"github.com/swdee/srkeyring/registry"
+)
+
+var (
+	errMissingSURI      = errors.New("missing --suri")
+	errInvalidSignature = errors.New("signature is not valid hex")
+	errSignatureInvalid = errors.New("Signature invalid.")
+)
+
+// signCmd signs the message and outputs the hex encoded signature
+func signCmd(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+
+	var opts keyOptions
+	var suri, msg string
+	var isHex bool
+
+	fs := newFlagSet("sign", stderr)
+	opts.register(fs)
+	fs.StringVar(&suri, "suri", "", "Secret URI of the key to sign with")
+	fs.StringVar(&msg, "message", "", "message to sign, read from stdin if not given")
+	fs.BoolVar(&isHex, "hex", false, "the message is hex encoded")
+
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if suri == "" {
+		return errMissingSURI
+	}
+
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	net, _ := opts.net()
+	scheme, _ := opts.keyScheme()
+
+	data, err := readMessage(msg, isHex, stdin)
+
+	if err != nil {
+		return err
+	}
+
+	kp, err := srkeyring.FromURIWithScheme(opts.withPassword(expandURI(suri)), net, scheme)
+
+	if err != nil {
+		return err
+	}
+
+	sig, err := kp.SignMessage(data)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(stdout, hex.EncodeToString(sig))
+
+	return err
+}
+
+// verifyCmd verifies the signature of the message against the public key
+// given as hex or an SS58 address
+func verifyCmd(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+
+	var opts keyOptions
+	var msg string
+	var isHex bool
+
+	fs := newFlagSet("verify", stderr)
+	opts.register(fs)
+	fs.StringVar(&msg, "message", "", "message to verify, read from stdin if not given")
+	fs.BoolVar(&isHex, "hex", false, "the message is hex encoded")
+
+	pos, err := parseFlags(fs, args)
+
+	if err != nil {
+		return err
+	}
+
+	if len(pos) != 2 {
+		return errMissingArg
+	}
+
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	net, _ := opts.net()
+	scheme, _ := opts.keyScheme()
+
+	sig, ok := srkeyring.DecodeHex(pos[0], "0x")
+
+	if !ok {
+		return errInvalidSignature
+	}
+
+	data, err := readMessage(msg, isHex, stdin)
+
+	if err != nil {
+		return err
+	}
+
+	kp, err := verifyKeyPair(pos[1], net, scheme)
+
+	if err != nil {
+		return err
+	}
+
+	if !kp.VerifyMessage(data, sig) {
+		return errSignatureInvalid
+	}
+
+	_, err = fmt.Fprintln(stdout, "Signature verifies correctly.")
+
+	return err
+}
+
+// verifyKeyPair returns the public key pair of the hex encoded public key or
+// SS58 address
+func verifyKeyPair(uri string, net srkeyring.Network, scheme srkeyring.Scheme) (
+	srkeyring.KeyPair, error) {
+
+	if _, ok := srkeyring.DecodeHex(uri, "0x"); ok {
+		return publicKeyPair(uri, net, scheme)
+	}
+
+	if scheme == srkeyring.Ecdsa {
+		return nil, srkeyring.ErrPublicKeyUnavailable
+	}
+
+	raw, prefix, err := srkeyring.DecodeAnySS58Address(uri, srkeyring.SS58Checksum)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if n, err := registry.ByPrefix(prefix); err == nil {
+		net = n
+	}
+
+	return publicKeyPair(hex.EncodeToString(raw[:]), net, scheme)
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/swdee/srkeyring"
+)
+
+var errMissingPattern = errors.New("missing --pattern")
+
+// vanityCmd searches for a random mnemonic, or seed with --seed-only, with an
+// SS58 address containing the pattern and outputs its key details
+func vanityCmd(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+
+	var opts keyOptions
+	var pattern string
+	var prefix, regex, ignoreCase, seedOnly bool
+	var workers int
+
+	fs := newFlagSet("vanity", stderr)
+	opts.register(fs)
+	fs.StringVar(&pattern, "pattern", "", "pattern the SS58 address must contain")
+	fs.BoolVar(&prefix, "prefix", false, "match the pattern at the start of the address")
+	fs.BoolVar(&regex, "regex", false, "match the pattern as a regular expression")
+	fs.BoolVar(&ignoreCase, "ignore-case", false, "match the pattern case-insensitively")
+	fs.BoolVar(&seedOnly, "seed-only", false, "search faster using random seeds which have no mnemonic to back up")
+	fs.IntVar(&workers, "workers", 0, "number of concurrent workers, defaults to the number of CPUs")
+
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if pattern == "" {
+		return errMissingPattern
+	}
+
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	net, _ := opts.net()
+	scheme, _ := opts.keyScheme()
+
+	vopts := srkeyring.VanityOptions{
+		Pattern:    pattern,
+		IgnoreCase: ignoreCase,
+		Scheme:     scheme,
+		Network:    net,
+		Workers:    workers,
+		SeedOnly:   seedOnly,
+	}
+
+	switch {
+	case regex:
+		vopts.Match = srkeyring.VanityRegex
+	case prefix:
+		vopts.Match = srkeyring.VanityPrefix
+	}
+
+	fmt.Fprintf(stderr, "Generating key containing pattern '%s'\n", pattern)
+
+	res, err := srkeyring.Vanity(context.Background(), vopts)
+
+	if err != nil {
+		return err
+	}
+
+	kind := phraseURI
+
+	if seedOnly {
+		kind = secretURI
+	}
+
+	info, err := describeKey(res.KeyPair, kind, res.SURI, "", net)
+
+	if err != nil {
+		return err
+	}
+
+	return printKey(stdout, info, opts.outputType)
+}
@@ -0,0 +1,146 @@
+package srkeyring
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+	"math"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrInvalidDiceRoll     = errors.New("Dice rolls must only contain the digits 1 to 6")
+	ErrInvalidCoinFlip     = errors.New("Coin flips must only contain the digits 0 or 1")
+	ErrInsufficientEntropy = errors.New("Not enough dice rolls or coin flips for the word count")
+)
+
+// DiceEntropy returns the mnemonic entropy for the given number of words from
+// a sequence of six sided dice rolls, such as "3512...", with whitespace
+// ignored.  Each roll provides log2(6) bits of entropy so at least 50 rolls
+// are required for 12 words and 100 rolls for 24 words.
+//
+// The entropy is the first 16 to 32 bytes, as needed for the word count, of
+// the SHA-256 hash of the rolls as ASCII digits with whitespace removed, so
+// can be recomputed by hand with "printf 3512... | sha256sum"
+func DiceEntropy(rolls string, words int) ([]byte, error) {
+	return physicalEntropy(rolls, "123456", 6, words, ErrInvalidDiceRoll)
+}
+
+// CoinEntropy returns the mnemonic entropy for the given number of words from
+// a sequence of coin flips, such as "0110...", with whitespace ignored.  Each
+// flip provides one bit of entropy so at least 128 flips are required for 12
+// words and 256 flips for 24 words.  The entropy is created from the SHA-256
+// hash of the flips in the same way as DiceEntropy
+func CoinEntropy(flips string, words int) ([]byte, error) {
+	return physicalEntropy(flips, "01", 2, words, ErrInvalidCoinFlip)
+}
+
+// physicalEntropy returns the entropy for the word count from the hash of the
+// outcomes of a physical source with the given number of sides
+func physicalEntropy(outcomes, valid string, sides float64, words int,
+	errInvalid error) ([]byte, error) {
+
+	bitsize, ok := entropyWords[WordCount(words)]
+
+	if !ok {
+		return nil, ErrInvalidWordCount
+	}
+
+	outcomes = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+
+		return r
+	}, outcomes)
+
+	for _, r := range outcomes {
+		if !strings.ContainsRune(valid, r) {
+			return nil, errInvalid
+		}
+	}
+
+	if len(outcomes) < minOutcomes(bitsize, sides) {
+		return nil, ErrInsufficientEntropy
+	}
+
+	hash := sha256.Sum256([]byte(outcomes))
+
+	return hash[:bitsize/8], nil
+}
+
+// minOutcomes returns the number of outcomes of a physical source with the
+// given number of sides needed to provide the bits of entropy
+func minOutcomes(bits entropyBits, sides float64) int {
+	return int(math.Ceil(float64(bits) / math.Log2(sides)))
+}
+
+// PhysicalOptions defines how a new KeyRing mnemonic is generated from dice
+// rolls or coin flips
+type PhysicalOptions struct {
+	// GenerateOptions are the word count, language, network, and source of
+	// randomness used when mixing
+	GenerateOptions
+	// Mix mixes the entropy of the dice rolls or coin flips with entropy read
+	// from Rand
+	Mix bool
+}
+
+// GenerateFromDice creates a new KeyRing with a mnemonic created from the
+// entropy of the dice rolls, see DiceEntropy.  When opts.Mix is set the
+// entropy is mixed with entropy read from opts.Rand
+func GenerateFromDice(rolls string, opts PhysicalOptions) (*KeyRing, error) {
+
+	opts.GenerateOptions = opts.withDefaults()
+	entropy, err := DiceEntropy(rolls, opts.Words)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return generateFromPhysical(entropy, opts)
+}
+
+// GenerateFromCoins creates a new KeyRing with a mnemonic created from the
+// entropy of the coin flips, see CoinEntropy.  When opts.Mix is set the
+// entropy is mixed with entropy read from opts.Rand
+func GenerateFromCoins(flips string, opts PhysicalOptions) (*KeyRing, error) {
+
+	opts.GenerateOptions = opts.withDefaults()
+	entropy, err := CoinEntropy(flips, opts.Words)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return generateFromPhysical(entropy, opts)
+}
+
+// generateFromPhysical creates the KeyRing from the physical entropy, first
+// XORing it with entropy read from opts.Rand when mixing.  XORing with
+// independent randomness leaves the result at least as random as either
+// source, so a weak source does not weaken the other, however the mixed
+// mnemonic can no longer be recomputed from the physical outcomes
+func generateFromPhysical(entropy []byte, opts PhysicalOptions) (*KeyRing, error) {
+
+	if opts.Mix {
+		mix := make([]byte, len(entropy))
+
+		if _, err := io.ReadFull(opts.Rand, mix); err != nil {
+			return nil, err
+		}
+
+		for i := range entropy {
+			entropy[i] ^= mix[i]
+		}
+	}
+
+	mnemonic, err := entropyToMnemonic(entropy, opts.Language)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return FromURI(mnemonic, opts.Network)
+}
@@ -0,0 +1,255 @@
+package srkeyring
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+)
+
+// Note: expected entropy is calculated from the command line with
+// "printf <rolls> | sha256sum"
+
+func TestPhysicalEntropy(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		outcomes string
+		coins    bool
+		words    int
+		entropy  string
+		err      error
+	}{
+		{
+			name:     "Dice 24 Words",
+			outcomes: strings.Repeat("1234563", 15),
+			words:    24,
+			entropy:  "b2152a96cac4bb3571637f2f6530b50999172cf1e63424d89e2b86d48359ef9c",
+		},
+		{
+			name:     "Dice with Whitespace",
+			outcomes: strings.TrimSpace(strings.Repeat("1234 563\n", 15)),
+			words:    24,
+			entropy:  "b2152a96cac4bb3571637f2f6530b50999172cf1e63424d89e2b86d48359ef9c",
+		},
+		{
+			name:     "Dice 12 Words",
+			outcomes: strings.Repeat("1234563", 15)[:50],
+			words:    12,
+			entropy:  "a9ef2f4cf307ecb5e2ac908b63558f45",
+		},
+		{
+			name:     "Dice Insufficient",
+			outcomes: strings.Repeat("1234563", 15)[:49],
+			words:    12,
+			err:      ErrInsufficientEntropy,
+		},
+		{
+			name:     "Dice Invalid Roll",
+			outcomes: strings.Repeat("1234567", 15),
+			words:    24,
+			err:      ErrInvalidDiceRoll,
+		},
+		{
+			name:     "Coins 24 Words",
+			outcomes: strings.Repeat("0110", 64),
+			coins:    true,
+			words:    24,
+			entropy:  "a25ceb2401bb35ca72127f8ff3e748501d6369bd88e42e902dce23b75048bf56",
+		},
+		{
+			name:     "Coins 12 Words",
+			outcomes: strings.Repeat("0110", 32),
+			coins:    true,
+			words:    12,
+			entropy:  "4628172db84d82384d46c34f5c25ef7a",
+		},
+		{
+			name:     "Coins Insufficient",
+			outcomes: strings.Repeat("0110", 32)[:127],
+			coins:    true,
+			words:    12,
+			err:      ErrInsufficientEntropy,
+		},
+		{
+			name:     "Coins Invalid Flip",
+			outcomes: strings.Repeat("0112", 32),
+			coins:    true,
+			words:    12,
+			err:      ErrInvalidCoinFlip,
+		},
+		{
+			name:     "Invalid Word Count",
+			outcomes: strings.Repeat("1234563", 15),
+			words:    13,
+			err:      ErrInvalidWordCount,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var entropy []byte
+			var err error
+
+			if tt.coins {
+				entropy, err = CoinEntropy(tt.outcomes, tt.words)
+			} else {
+				entropy, err = DiceEntropy(tt.outcomes, tt.words)
+			}
+
+			if err != tt.err {
+				t.Fatalf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+
+			if hex.EncodeToString(entropy) != tt.entropy {
+				t.Errorf("Invalid entropy, expected %v, got %x", tt.entropy, entropy)
+			}
+		})
+	}
+}
+
+func TestMinOutcomes(t *testing.T) {
+
+	tests := []struct {
+		words int
+		dice  int
+		coins int
+	}{
+		{12, 50, 128},
+		{15, 62, 160},
+		{18, 75, 192},
+		{21, 87, 224},
+		{24, 100, 256},
+	}
+
+	for _, tt := range tests {
+		bits := entropyWords[WordCount(tt.words)]
+
+		if n := minOutcomes(bits, 6); n != tt.dice {
+			t.Errorf("Invalid dice rolls for %d words, expected %d, got %d", tt.words, tt.dice, n)
+		}
+
+		if n := minOutcomes(bits, 2); n != tt.coins {
+			t.Errorf("Invalid coin flips for %d words, expected %d, got %d", tt.words, tt.coins, n)
+		}
+	}
+}
+
+func TestGenerateFromPhysical(t *testing.T) {
+
+	rolls := strings.Repeat("1234563", 15)
+	flips := strings.Repeat("0110", 64)
+
+	tests := []struct {
+		name    string
+		coins   bool
+		opts    PhysicalOptions
+		entropy string
+		err     error
+	}{
+		{
+			name:    "Dice",
+			opts:    PhysicalOptions{GenerateOptions: GenerateOptions{Words: 24}},
+			entropy: "b2152a96cac4bb3571637f2f6530b50999172cf1e63424d89e2b86d48359ef9c",
+		},
+		{
+			name: "Dice Mixed",
+			opts: PhysicalOptions{
+				GenerateOptions: GenerateOptions{
+					Words: 24,
+					Rand:  bytes.NewReader(bytes.Repeat([]byte{0xff}, 32)),
+				},
+				Mix: true,
+			},
+			entropy: "4dead569353b44ca8e9c80d09acf4af666e8d30e19cbdb2761d4792b7ca61063",
+		},
+		{
+			name: "Dice Mixed Short Read",
+			opts: PhysicalOptions{
+				GenerateOptions: GenerateOptions{
+					Words: 24,
+					Rand:  bytes.NewReader(make([]byte, 31)),
+				},
+				Mix: true,
+			},
+			err: io.ErrUnexpectedEOF,
+		},
+		{
+			name:    "Dice Default Word Count",
+			opts:    PhysicalOptions{GenerateOptions: GenerateOptions{Language: Spanish}},
+			entropy: "b2152a96cac4bb3571637f2f6530b509",
+		},
+		{
+			name:    "Coins",
+			coins:   true,
+			opts:    PhysicalOptions{GenerateOptions: GenerateOptions{Words: 24}},
+			entropy: "a25ceb2401bb35ca72127f8ff3e748501d6369bd88e42e902dce23b75048bf56",
+		},
+		{
+			name:  "Coins Insufficient",
+			coins: true,
+			opts:  PhysicalOptions{GenerateOptions: GenerateOptions{Words: 24}, Mix: true},
+			err:   ErrInsufficientEntropy,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var kr *KeyRing
+			var err error
+
+			if tt.coins {
+				outcomes := flips
+
+				if tt.err == ErrInsufficientEntropy {
+					outcomes = flips[:255]
+				}
+
+				kr, err = GenerateFromCoins(outcomes, tt.opts)
+			} else {
+				kr, err = GenerateFromDice(rolls, tt.opts)
+			}
+
+			if err != tt.err {
+				t.Fatalf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+
+			if tt.err != nil {
+				return
+			}
+
+			entropy, err := kr.Entropy()
+
+			if err != nil {
+				t.Fatalf("Error getting entropy: %v", err)
+			}
+
+			if hex.EncodeToString(entropy) != tt.entropy {
+				t.Errorf("Invalid entropy, expected %v, got %x", tt.entropy, entropy)
+			}
+
+			mnemonic, err := kr.Mnemonic()
+
+			if err != nil {
+				t.Fatalf("Error getting mnemonic phrase: %v", err)
+			}
+
+			lang, err := DetectLanguage(mnemonic)
+
+			if err != nil {
+				t.Fatalf("Error detecting language: %v", err)
+			}
+
+			if expected := tt.opts.withDefaults().Language; lang != expected {
+				t.Errorf("Invalid language, expected %v, got %v", expected, lang)
+			}
+		})
+	}
+}
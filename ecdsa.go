@@ -0,0 +1,257 @@
+package srkeyring
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	secpecdsa "github.com/decred/dcrd/dcrec/secp256k1/v3/ecdsa"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// secp256k1HDKD is the domain separator used by Substrate for ecdsa hard
+	// key derivation
+	secp256k1HDKD = "Secp256k1HDKD"
+
+	// EcdsaPublicKeyLength is the length of the compressed ecdsa public key
+	EcdsaPublicKeyLength = 33
+
+	// EcdsaSignatureLength is the length of the recoverable ecdsa signature
+	EcdsaSignatureLength = 65
+
+	// compactSigRecoveryOffset is the offset added to the recovery id in the
+	// first byte of a compact signature by the secp256k1 library for a
+	// compressed public key
+	compactSigRecoveryOffset = 27 + 4
+)
+
+var (
+	ErrInvalidSecretKey     = errors.New("Secret seed is not a valid secp256k1 private key")
+	ErrPublicKeyUnavailable = errors.New("Public key can not be recovered from an SS58 address for this key scheme")
+)
+
+// EcdsaKeyRing defines an ecdsa (secp256k1) key pair from a derived Secret
+// URI compatible with Substrates ecdsa scheme
+type EcdsaKeyRing struct {
+	// secret is the private key
+	secret *secp256k1.PrivateKey
+	// pub is the public key
+	pub *secp256k1.PublicKey
+	// hasSecret is a flag to indicate if the KeyRing has a "secret" private key
+	// value set.  A KeyRing created from a public key only has the
+	// public key available
+	hasSecret bool
+	// suri is the parsed SecretURI
+	suri *SecretURI
+}
+
+// EcdsaFromPublic returns an EcdsaKeyRing from the raw bytes of a compressed
+// public key
+func EcdsaFromPublic(b [EcdsaPublicKeyLength]byte, net Network) (*EcdsaKeyRing, error) {
+
+	pk, err := secp256k1.ParsePubKey(b[:])
+
+	if err != nil {
+		return nil, err
+	}
+
+	kr := &EcdsaKeyRing{
+		suri: &SecretURI{
+			Network: net,
+			Type:    RawPublicKey,
+		},
+		pub: pk,
+	}
+
+	return kr, nil
+}
+
+// EcdsaFromURI returns an EcdsaKeyRing from the given Secret URI.  Only
+// Hard key derivation is supported, Soft junctions in the path return an error
+func EcdsaFromURI(str string, net Network) (*EcdsaKeyRing, error) {
+
+	suri, err := NewSecretURI(str, net)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := DecodeSS58Address(suri.Phrase, net, SS58Checksum); err == nil {
+		// the ss58 address of an ecdsa key is the hash of the public key so
+		// the public key can not be recovered from it
+		return nil, ErrPublicKeyUnavailable
+	}
+
+	junctions, err := suri.GetJunctions()
+
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := suri.SecretSeed()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, jun := range junctions {
+
+		if !jun.hard {
+			return nil, ErrSoftDerivation
+		}
+
+		seed, err = deriveEcdsaHard(seed, jun.chainCode)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	secret, err := newEcdsaSecret(seed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	kr := &EcdsaKeyRing{
+		secret:    secret,
+		pub:       secret.PubKey(),
+		hasSecret: true,
+		suri:      suri,
+	}
+
+	return kr, nil
+}
+
+// newEcdsaSecret returns the secp256k1 private key from the seed, checking
+// it is within the valid range of the curve order
+func newEcdsaSecret(seed [32]byte) (*secp256k1.PrivateKey, error) {
+
+	var key secp256k1.ModNScalar
+
+	if overflow := key.SetBytes(&seed); overflow != 0 || key.IsZero() {
+		return nil, ErrInvalidSecretKey
+	}
+
+	return secp256k1.NewPrivateKey(&key), nil
+}
+
+// deriveEcdsaHard derives the child seed from the given seed and chain code
+// by hashing the SCALE encoded tuple ("Secp256k1HDKD", seed, chainCode)
+func deriveEcdsaHard(seed [32]byte, cc [32]byte) ([32]byte, error) {
+
+	cl, err := compactUint(uint64(len(secp256k1HDKD)))
+
+	if err != nil {
+		return seed, err
+	}
+
+	buf := append(cl, secp256k1HDKD...)
+	buf = append(buf, seed[:]...)
+	buf = append(buf, cc[:]...)
+
+	return blake2b.Sum256(buf), nil
+}
+
+// Sign signs the blake2b 256 hash of the message using the secret key and
+// returns the 65 byte recoverable signature in the format R || S || V
+func (k *EcdsaKeyRing) Sign(msg []byte) (signature [EcdsaSignatureLength]byte, err error) {
+
+	if !k.hasSecret {
+		return signature, ErrNoSecretKey
+	}
+
+	hash := blake2b.Sum256(msg)
+
+	// compact signature is in the format <recovery code><32-byte R><32-byte S>
+	sig := secpecdsa.SignCompact(k.secret, hash[:], true)
+
+	copy(signature[:64], sig[1:])
+	signature[64] = sig[0] - compactSigRecoveryOffset
+
+	return signature, nil
+}
+
+// Verify the message against the signature by recovering the public key
+// from the signature and comparing it to the KeyRing's public key
+func (k *EcdsaKeyRing) Verify(msg []byte, signature [EcdsaSignatureLength]byte) bool {
+
+	if signature[64] > 3 {
+		return false
+	}
+
+	hash := blake2b.Sum256(msg)
+
+	sig := make([]byte, 0, EcdsaSignatureLength)
+	sig = append(sig, signature[64]+compactSigRecoveryOffset)
+	sig = append(sig, signature[:64]...)
+
+	pub, _, err := secpecdsa.RecoverCompact(sig, hash[:])
+
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(pub.SerializeCompressed(), k.pub.SerializeCompressed())
+}
+
+// Public returns the compressed public key in raw bytes
+func (k *EcdsaKeyRing) Public() [EcdsaPublicKeyLength]byte {
+	var pub [EcdsaPublicKeyLength]byte
+	copy(pub[:], k.pub.SerializeCompressed())
+	return pub
+}
+
+// PublicHex returns the compressed public key hex encoded
+func (k *EcdsaKeyRing) PublicHex() string {
+	pub := k.Public()
+	return EncodeHex(pub[:], k.suri.Network.AddressPrefix())
+}
+
+// AccountID returns the blake2b 256 hash of the compressed public key which
+// is used as the account address
+func (k *EcdsaKeyRing) AccountID() [32]byte {
+	pub := k.Public()
+	return blake2b.Sum256(pub[:])
+}
+
+// Mnemonic returns the mnemonic phrase if the KeyRing was generated by a
+// mnemonic phrase or an error if generated by other source
+func (k *EcdsaKeyRing) Mnemonic() (string, error) {
+	if k.suri.Type == Mnemonic {
+		return k.suri.Phrase, nil
+	}
+
+	return "", ErrNonMnemonic
+}
+
+// Seed returns the secret seed which is the private key in raw bytes
+func (k *EcdsaKeyRing) Seed() ([32]byte, error) {
+	var res [32]byte
+
+	if !k.hasSecret {
+		return res, ErrSeedNotAvailable
+	}
+
+	copy(res[:], k.secret.Serialize())
+	return res, nil
+}
+
+// SeedHex returns the secret seed hex encoded
+func (k *EcdsaKeyRing) SeedHex() (string, error) {
+	raw, err := k.Seed()
+	return EncodeHex(raw[:], k.suri.Network.AddressPrefix()), err
+}
+
+// SS58PublicKey returns the compressed public key encoded as SS58, which
+// differs from the SS58Address as it is the public key and not the account id
+func (k *EcdsaKeyRing) SS58PublicKey() (string, error) {
+	pub := k.Public()
+	return ss58Encode(pub[:], k.suri.Network, SS58Checksum)
+}
+
+// SS58Address returns the account id encoded as a SS58 address
+func (k *EcdsaKeyRing) SS58Address() (string, error) {
+	return SS58Address(k.AccountID(), k.suri.Network, SS58Checksum)
+}
@@ -0,0 +1,284 @@
+package srkeyring
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// Note: ecdsa signatures use RFC6979 deterministic nonces so the test vectors
+// include the signature of the message "test message" as output by
+// subkey sign --scheme ecdsa
+
+var ecdsaTests = []struct {
+	name    string
+	suri    string
+	seed    string
+	public  string
+	account string
+	ss58    string
+	sig     string
+}{
+	{
+		name:    "Dev Alice",
+		suri:    "bottom drive obey lake curtain smoke basket hold race lonely fit walk//Alice",
+		seed:    "0xcb6df9de1efca7a3998a8ead4e02159d5fa99c3e0d4fd6432667390bb4726854",
+		public:  "0x020a1091341fe5664bfa1782d5e04779689068c916b04cb365ec3153755684d9a1",
+		account: "0x01e552298e47454041ea31273b4b630c64c104e4514aa3643490b8aaca9cf8ed",
+		ss58:    "5C7C2Z5sWbytvHpuLTvzKunnnRwQxft1jiqrLD5rhucQ5S9X",
+		sig:     "eb3ff698dc3ba9c852620ff4febd57fde9d90d017939e7534b0e7d5d472782d60baf46514df3a0329ba78851e71d6e3e726818efded838e452630dc1027853c000",
+	},
+	{
+		name:    "Dev Bob",
+		suri:    "bottom drive obey lake curtain smoke basket hold race lonely fit walk//Bob",
+		seed:    "0x79c3b7fc0b7697b9414cb87adcb37317d1cab32818ae18c0e97ad76395d1fdcf",
+		public:  "0x0390084fdbf27d2b79d26a4f13f0ccd982cb755a661969143c37cbc49ef5b91f27",
+		account: "0x3f6eaf1be5add88d84ca8b02d350074935dbf04f53f4287cb6abfd6b33413f8f",
+		ss58:    "5DVskgSC9ncWQpxFMeUn45NU43RUq93ByEge6ApbnLk6BR9N",
+		sig:     "25665648fc26c2b2a1f8dcb5664e964ce52d722ad189e620fc8b19f77cf24a5c5fa8539cf788738ebc06ef23083746c8497cffb2cccb50b9cd00914f47a1a20b01",
+	},
+	{
+		name:    "Mnemonic 12 Words",
+		suri:    "zebra extra skill occur rose muscle reveal robust cigar tilt jungle coral",
+		seed:    "0x207e1f885ec7d61421e8ae9eab882d33a1569073c73433c7e7b3042a213bd201",
+		public:  "0x02238a3f246e1939faa44ebc14ce2a0ce0355da8577c9206bda24b12217d0e93a3",
+		account: "0xf1cde71b8a82156b41cf9389cad19f9129ed7b2bb96b962e18dd4e16b1d577fc",
+		ss58:    "5HXkZd6eKyvZhbm7y9WDQkcvYpnBWV2g8P2pXaCseBY8kPNu",
+		sig:     "9c7f334a509ca6b004cf2f9143c9cfb760f8df2e3e7fd0351a1f02f9f96a5d300b6bf5323d41a8e2588ce98feb133dd56bce2a955bc20673802c3d93438ff55400",
+	},
+	{
+		name:    "Mnemonic 12 Words With Password",
+		suri:    "zebra extra skill occur rose muscle reveal robust cigar tilt jungle coral///pass1234",
+		seed:    "0xc72e70f7b35310453060126a9745248e8af2bf5191872081f8330fe46f0ed1da",
+		public:  "0x02bfcdbf77b0f5ea176168580111fdcd48ba4289630112325772636a3ae77f6d09",
+		account: "0x3d6c5d9132aa020727f89fa54cad7ec11505181dcfe3a2fb4197bb698008d00b",
+		ss58:    "5DTEyBL11dkQ9hefBS6Rf5vS6jLLKnaNMpYB93hektEqwY3Y",
+		sig:     "378c44289cd3397599a1bb06b4d93c390bd829986a2fbca4a39e263747bb8c027b1f26be1deef3051f5ba95aebdadfae3b284c4e71be2c6721d88332ce76855400",
+	},
+	{
+		name:    "Mnemonic 12 Words with Hard Path",
+		suri:    "zebra extra skill occur rose muscle reveal robust cigar tilt jungle coral//john//account//1",
+		seed:    "0xd1f0c642c24e097c15d5c8a36f207c5d4bac97990c77dace34425b7ad71269fd",
+		public:  "0x039eb04d67a57c77558acddf0869ba4673ebb264386b77834621f9519be886b27c",
+		account: "0x758ae1a030814af7a0c6320f2f0279185ad15d2c4c5953c5f2f6c243815dd4c3",
+		ss58:    "5EipiV3W3E5thkGxrdGCuCMQbb5rpFQNNtxWveSNm8DRvPin",
+		sig:     "43e726ffc4cbd1bc1d99ca4396a779deca4c5774e113e1d98855ec74cdf32fb073d68cfdcd886b0c8c731ccb1a3722e4e0dd2a9c9e6591602ef478ef89c22c5101",
+	},
+	{
+		name:    "Secret Seed",
+		suri:    "0x7202a4eba69bb283e8e9a3f5f6f0fc64bb02e6d20fb4b6bde13caec148f2cca7",
+		seed:    "0x7202a4eba69bb283e8e9a3f5f6f0fc64bb02e6d20fb4b6bde13caec148f2cca7",
+		public:  "0x03bb7f67d032dd9458d241854b011d1e78872f9440798b40353e0e2cebda39c9aa",
+		account: "0xb7fe2174c9d42e340790557ade19b4a33147deb60bc5b827dcefb7af848adef8",
+		ss58:    "5GDx7VBwhMsAigAY5sziVE6yWUqbs8g5zVHnCEUQG73WQ5cY",
+		sig:     "4bdbe1e0362b9780a600ee45e0120ed9c9be842e6fc651745016d1378d13f89a11716e050992197a32bcb1395af61c833a5ff3b668d5fb78770bb1d42d624d4101",
+	},
+	{
+		name:    "Secret Seed with Hard Path",
+		suri:    "0x7202a4eba69bb283e8e9a3f5f6f0fc64bb02e6d20fb4b6bde13caec148f2cca7//polkadot//0",
+		seed:    "0x42036b4c733bcc73e554b0c25bcd7ca92be8977c70b428409f4997ee3c774c69",
+		public:  "0x02a22b335f45c3012da616dc965ba452f6a27c1ca7ae7ba98ccae7a50a06d55b1d",
+		account: "0x743266719ef906fc6783dcdf5fe35314c6e58d46e5cc7f33a8c26c645f907306",
+		ss58:    "5Eh4PDEg2FaCgLNX6kBM9wr7DGty4c3586qU41iTnYzbQPa5",
+		sig:     "e864421f99d94dcb6b678a4ca740ead6a8079ac6fd5de945fc70159d4ef44fdc74f699d21eb206fbc90f42b50aee270cb48067a09f737c747f6ac0c9841a966501",
+	},
+}
+
+func TestEcdsaFromURI(t *testing.T) {
+
+	for _, tt := range ecdsaTests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kr, err := EcdsaFromURI(tt.suri, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error generating key ring: %v", err)
+			}
+
+			seed, err := kr.SeedHex()
+
+			if err != nil {
+				t.Fatalf("Error getting seed: %v", err)
+			}
+
+			if seed != tt.seed {
+				t.Errorf("Invalid seed, expected %v, got %v", tt.seed, seed)
+			}
+
+			if kr.PublicHex() != tt.public {
+				t.Errorf("Invalid public key, expected %v, got %v", tt.public, kr.PublicHex())
+			}
+
+			acc := kr.AccountID()
+			accHex := EncodeHex(acc[:], "0x")
+
+			if accHex != tt.account {
+				t.Errorf("Invalid account id, expected %v, got %v", tt.account, accHex)
+			}
+
+			ss58, err := kr.SS58Address()
+
+			if err != nil {
+				t.Fatalf("Error getting SS58 Address: %v", err)
+			}
+
+			if ss58 != tt.ss58 {
+				t.Errorf("Invalid SS58 Address, expected %v, got %v", tt.ss58, ss58)
+			}
+		})
+	}
+}
+
+func TestEcdsaSign(t *testing.T) {
+
+	for _, tt := range ecdsaTests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kr, err := EcdsaFromURI(tt.suri, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error generating key ring: %v", err)
+			}
+
+			sig, err := kr.Sign([]byte("test message"))
+
+			if err != nil {
+				t.Fatalf("Error signing message: %v", err)
+			}
+
+			if hex.EncodeToString(sig[:]) != tt.sig {
+				t.Errorf("Invalid signature, expected %v, got %x", tt.sig, sig)
+			}
+		})
+	}
+}
+
+func TestEcdsaVerify(t *testing.T) {
+
+	for _, tt := range ecdsaTests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pub, ok := DecodeHex(tt.public, "0x")
+
+			if !ok {
+				t.Fatalf("Invalid hex encoded public key: %v", tt.public)
+			}
+
+			var pubB [EcdsaPublicKeyLength]byte
+			copy(pubB[:], pub)
+
+			kr, err := EcdsaFromPublic(pubB, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error generating key ring: %v", err)
+			}
+
+			sig, err := hex.DecodeString(tt.sig)
+
+			if err != nil {
+				t.Fatalf("Invalid hex decode: %v", err)
+			}
+
+			var sigB [EcdsaSignatureLength]byte
+			copy(sigB[:], sig)
+
+			if !kr.Verify([]byte("test message"), sigB) {
+				t.Errorf("Error signature does not verify")
+			}
+
+			if kr.Verify([]byte("other message"), sigB) {
+				t.Errorf("Error signature verified for wrong message")
+			}
+
+			if _, err := kr.Sign([]byte("test message")); err != ErrNoSecretKey {
+				t.Errorf("Expected no secret key error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestEcdsaFromURIInvalid(t *testing.T) {
+
+	tests := []struct {
+		name string
+		suri string
+		err  error
+	}{
+		{
+			name: "Soft Path",
+			suri: "zebra extra skill occur rose muscle reveal robust cigar tilt jungle coral/john",
+			err:  ErrSoftDerivation,
+		},
+		{
+			name: "SS58 Address",
+			suri: "5C7C2Z5sWbytvHpuLTvzKunnnRwQxft1jiqrLD5rhucQ5S9X",
+			err:  ErrPublicKeyUnavailable,
+		},
+		{
+			name: "Zero Secret Seed",
+			suri: "0x0000000000000000000000000000000000000000000000000000000000000000",
+			err:  ErrInvalidSecretKey,
+		},
+		{
+			name: "Secret Seed Above Curve Order",
+			suri: "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			err:  ErrInvalidSecretKey,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := EcdsaFromURI(tt.suri, NetSubstrate{})
+
+			if err != tt.err {
+				t.Errorf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestEcdsaSS58PublicKey(t *testing.T) {
+
+	tests := []struct {
+		name string
+		suri string
+		ss58 string
+	}{
+		{
+			name: "Dev Alice",
+			suri: devPhrase + "//Alice",
+			ss58: "KW39r9CJjAVzmkf9zQ4YDb2hqfAVGdRqn53eRqyruqpxAP5YL",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kr, err := EcdsaFromURI(tt.suri, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error generating key ring: %v", err)
+			}
+
+			ss58, err := kr.SS58PublicKey()
+
+			if err != nil {
+				t.Fatalf("Error getting SS58 public key: %v", err)
+			}
+
+			if ss58 != tt.ss58 {
+				t.Errorf("Invalid SS58 public key, expected %v, got %v", tt.ss58, ss58)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package srkeyring
+
+import (
+	"crypto/ed25519"
+	"errors"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// ed25519HDKD is the domain separator used by Substrate for ed25519 hard
+	// key derivation
+	ed25519HDKD = "Ed25519HDKD"
+)
+
+var (
+	ErrSoftDerivation   = errors.New("Soft key derivation is not supported by this key scheme")
+	ErrPublicDerivation = errors.New("Key derivation is not supported from a public key for this key scheme")
+	ErrNoSecretKey      = errors.New("KeyRing has no secret key available")
+)
+
+// Ed25519KeyRing defines an ed25519 key pair from a derived Secret URI
+// compatible with Substrates ed25519 scheme
+type Ed25519KeyRing struct {
+	// secret is the private key
+	secret ed25519.PrivateKey
+	// pub is the public key
+	pub ed25519.PublicKey
+	// hasSecret is a flag to indicate if the KeyRing has a "secret" private key
+	// value set.  A KeyRing created from a public address only has the
+	// public key available
+	hasSecret bool
+	// suri is the parsed SecretURI
+	suri *SecretURI
+}
+
+// Ed25519FromPublic returns an Ed25519KeyRing from the raw bytes of a
+// public key
+func Ed25519FromPublic(b [32]byte, net Network) (*Ed25519KeyRing, error) {
+
+	kr := &Ed25519KeyRing{
+		suri: &SecretURI{
+			Network: net,
+			Type:    RawPublicKey,
+		},
+		pub: append(ed25519.PublicKey{}, b[:]...),
+	}
+
+	return kr, nil
+}
+
+// Ed25519FromURI returns an Ed25519KeyRing from the given Secret URI.  Only
+// Hard key derivation is supported, Soft junctions in the path return an error
+func Ed25519FromURI(str string, net Network) (*Ed25519KeyRing, error) {
+
+	suri, err := NewSecretURI(str, net)
+
+	if err != nil {
+		return nil, err
+	}
+
+	junctions, err := suri.GetJunctions()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if raw, err := DecodeSS58Address(suri.Phrase, net, SS58Checksum); err == nil {
+		// ss58 encoded public address which can not be derived from
+		if len(junctions) > 0 {
+			return nil, ErrPublicDerivation
+		}
+
+		suri.Type = SS58Public
+
+		kr := &Ed25519KeyRing{
+			suri: suri,
+			pub:  append(ed25519.PublicKey{}, raw[:]...),
+		}
+
+		return kr, nil
+	}
+
+	seed, err := suri.SecretSeed()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, jun := range junctions {
+
+		if !jun.hard {
+			return nil, ErrSoftDerivation
+		}
+
+		seed, err = deriveEd25519Hard(seed, jun.chainCode)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	secret := ed25519.NewKeyFromSeed(seed[:])
+
+	kr := &Ed25519KeyRing{
+		secret:    secret,
+		pub:       secret.Public().(ed25519.PublicKey),
+		hasSecret: true,
+		suri:      suri,
+	}
+
+	return kr, nil
+}
+
+// deriveEd25519Hard derives the child seed from the given seed and chain code
+// by hashing the SCALE encoded tuple ("Ed25519HDKD", seed, chainCode)
+func deriveEd25519Hard(seed [32]byte, cc [32]byte) ([32]byte, error) {
+
+	cl, err := compactUint(uint64(len(ed25519HDKD)))
+
+	if err != nil {
+		return seed, err
+	}
+
+	buf := append(cl, ed25519HDKD...)
+	buf = append(buf, seed[:]...)
+	buf = append(buf, cc[:]...)
+
+	return blake2b.Sum256(buf), nil
+}
+
+// Sign signs the message using the secret key
+func (k *Ed25519KeyRing) Sign(msg []byte) (signature [64]byte, err error) {
+
+	if !k.hasSecret {
+		return signature, ErrNoSecretKey
+	}
+
+	copy(signature[:], ed25519.Sign(k.secret, msg))
+
+	return signature, nil
+}
+
+// Verify the message against the signature
+func (k *Ed25519KeyRing) Verify(msg []byte, signature [64]byte) bool {
+	return ed25519.Verify(k.pub, msg, signature[:])
+}
+
+// Public returns the public key in raw bytes
+func (k *Ed25519KeyRing) Public() [32]byte {
+	var pub [32]byte
+	copy(pub[:], k.pub)
+	return pub
+}
+
+// PublicHex returns the public key hex encoded
+func (k *Ed25519KeyRing) PublicHex() string {
+	pub := k.Public()
+	return EncodeHex(pub[:], k.suri.Network.AddressPrefix())
+}
+
+// Mnemonic returns the mnemonic phrase if the KeyRing was generated by a
+// mnemonic phrase or an error if generated by other source
+func (k *Ed25519KeyRing) Mnemonic() (string, error) {
+	if k.suri.Type == Mnemonic {
+		return k.suri.Phrase, nil
+	}
+
+	return "", ErrNonMnemonic
+}
+
+// Seed returns the secret seed the key pair was created from in raw bytes
+func (k *Ed25519KeyRing) Seed() ([32]byte, error) {
+	var res [32]byte
+
+	if !k.hasSecret {
+		return res, ErrSeedNotAvailable
+	}
+
+	copy(res[:], k.secret.Seed())
+	return res, nil
+}
+
+// SeedHex returns the secret seed hex encoded
+func (k *Ed25519KeyRing) SeedHex() (string, error) {
+	raw, err := k.Seed()
+	return EncodeHex(raw[:], k.suri.Network.AddressPrefix()), err
+}
+
+// SS58Address returns the public key encoded as a SS58 address
+func (k *Ed25519KeyRing) SS58Address() (string, error) {
+	return SS58Address(k.Public(), k.suri.Network, SS58Checksum)
+}
@@ -0,0 +1,234 @@
+package srkeyring
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// Note: ed25519 signatures are deterministic so the test vectors include the
+// signature of the message "test message" as output by
+// subkey sign --scheme ed25519
+
+var ed25519Tests = []struct {
+	name   string
+	suri   string
+	seed   string
+	public string
+	ss58   string
+	sig    string
+}{
+	{
+		name:   "Dev Alice",
+		suri:   "bottom drive obey lake curtain smoke basket hold race lonely fit walk//Alice",
+		seed:   "0xabf8e5bdbe30c65656c0a3cbd181ff8a56294a69dfedd27982aace4a76909115",
+		public: "0x88dc3417d5058ec4b4503e0c12ea1a0a89be200fe98922423d4334014fa6b0ee",
+		ss58:   "5FA9nQDVg267DEd8m1ZypXLBnvN7SFxYwV7ndqSYGiN9TTpu",
+		sig:    "65cf49656f49630d0baac61dba66398de9760cb58ed950f1c0511205d70995fb0573ccbc6da0d51676abcc4b9af28f05289eb8447376d4cdaf6c457b5cfed00d",
+	},
+	{
+		name:   "Dev Bob",
+		suri:   "bottom drive obey lake curtain smoke basket hold race lonely fit walk//Bob",
+		seed:   "0x3b7b60af2abcd57ba401ab398f84f4ca54bd6b2140d2503fbcf3286535fe3ff1",
+		public: "0xd17c2d7823ebf260fd138f2d7e27d114c0145d968b5ff5006125f2414fadae69",
+		ss58:   "5GoNkf6WdbxCFnPdAnYYQyCjAKPJgLNxXwPjwTh6DGg6gN3E",
+		sig:    "3a9874bd204da3efc5accc514103b61e1ef1d1de65ffb1a687164a397283bd0a911d145f6e24f2c4efa38a49eb466148e5ee774d636db3ba117598708dfe540b",
+	},
+	{
+		name:   "Mnemonic 12 Words",
+		suri:   "zebra extra skill occur rose muscle reveal robust cigar tilt jungle coral",
+		seed:   "0x207e1f885ec7d61421e8ae9eab882d33a1569073c73433c7e7b3042a213bd201",
+		public: "0xf0b6b536babacf72f58519c9348872420cad441141e66485356e9407e33e0670",
+		ss58:   "5HWKdCxuAJ3LXb2EWWThkLHptbwSqDgfcDiCGciFmTvgvxUA",
+		sig:    "6ed8c071890221eb7ff6ac54b0fd89be22d5cf7944f2f83ffdd046fec5b3c82abc1343f48e96cf5b02068fa70d0384cd6234528ec7e751d1b55509f7cc3c0f01",
+	},
+	{
+		name:   "Mnemonic 12 Words With Password",
+		suri:   "zebra extra skill occur rose muscle reveal robust cigar tilt jungle coral///pass1234",
+		seed:   "0xc72e70f7b35310453060126a9745248e8af2bf5191872081f8330fe46f0ed1da",
+		public: "0x1d37dd850e88950971753531675ec11efb89448e30c37ed7648a01b587113d09",
+		ss58:   "5Cj1qkKdVdBk6dJkXLCXsr15j28XUZEjU9hqTb3EzFCXPoPs",
+		sig:    "6dde899d4d5ee054b973c4b2c55cd9a110efa49051803a36ff138bdeefae49e3ad7daaef19ffb92b3386d0da0f0f001a1bd1c7ce7dc4fa76b76e5137edc2cf08",
+	},
+	{
+		name:   "Mnemonic 12 Words with Hard Path",
+		suri:   "zebra extra skill occur rose muscle reveal robust cigar tilt jungle coral//john//account//1",
+		seed:   "0x6db44507c531f88e3f2c86ee57983e8021b993f3d95edaaee212c72fbadc9c3a",
+		public: "0x69271532f0d8d0942e58090b92edee983a8aba03c1eaabc0264bb1e8948cb7f5",
+		ss58:   "5ESaVfgTEuitepwD7jRJ6igDYQqija1SFWPEhrFQ2zaf4RW3",
+		sig:    "7262b031ba75311cf173c5809406bb845db0b035f299279a921fbdf7daf23d756690473a19002aab02d5933f2312233f79f669dcfda5c908076c64a2fc25530b",
+	},
+	{
+		name:   "Mnemonic 12 Words with Hard Path and Password",
+		suri:   "occur myself unveil gun flight valid trash sail crack desk rhythm add//joe//account//1///pass1234",
+		seed:   "0x5eebbd16ff1d8c8070ec5c61e94aad17e14b3499fe658d1b5d76fc8cbf9b725a",
+		public: "0x8e799d45e6af80d2aeff12240183028e5598a3140d5c68843a306406ec4283c4",
+		ss58:   "5FHWnQwdxDqCs92h2qGa6Ze395xb9jzxTooGuBQD5hBEAyyX",
+		sig:    "5df0c9bd3c62704055aab9a93d21cee3029996db5b5c5ff92b32aa0ca1aa2a1e34c2ad91ec5c2ca03b4d767660b4f12f300311a57889506dac1c0b6766f6a605",
+	},
+	{
+		name:   "Secret Seed",
+		suri:   "0x7202a4eba69bb283e8e9a3f5f6f0fc64bb02e6d20fb4b6bde13caec148f2cca7",
+		seed:   "0x7202a4eba69bb283e8e9a3f5f6f0fc64bb02e6d20fb4b6bde13caec148f2cca7",
+		public: "0x0c8ec8d8d3c93ec60f80922477c1f9edc95dbec243b57eaf00e3e1fdf5b22004",
+		ss58:   "5CMAr7kRzoPtdnNGBVWKx9QkB42ddTLRiEyRmvqL9m1gbfoc",
+		sig:    "1e01b5ca47a7fab8118042ba6a11f23237e4bcbabd23d11b7ff451b7438c90cddd4881c59948043130883f62e6235dff82153bdc9c8dac07da68761aa6f56203",
+	},
+	{
+		name:   "Secret Seed with Hard Path",
+		suri:   "0x7202a4eba69bb283e8e9a3f5f6f0fc64bb02e6d20fb4b6bde13caec148f2cca7//polkadot//0",
+		seed:   "0x5093cb1340b5e9a4b5c339d9bd9a5ff958dd6967f34ad11c039601944139fa21",
+		public: "0xd11ef07ba20710109b42ebe22f17354dd81aae9cdcd57aa75d43835501565a2f",
+		ss58:   "5Gnu4CaGC1DRqa3W7LHAdUaTwQuiGQNHtoKz41S6sgxWrMVA",
+		sig:    "0c4273829bccc94a2dc912c03df39bb822957642534d1d1983bdd735931415e23b4ce2a44639c452b2a38f37f025ea1c56da31cbffc7bd234938285d842d9101",
+	},
+}
+
+func TestEd25519FromURI(t *testing.T) {
+
+	for _, tt := range ed25519Tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kr, err := Ed25519FromURI(tt.suri, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error generating key ring: %v", err)
+			}
+
+			seed, err := kr.SeedHex()
+
+			if err != nil {
+				t.Fatalf("Error getting seed: %v", err)
+			}
+
+			if seed != tt.seed {
+				t.Errorf("Invalid seed, expected %v, got %v", tt.seed, seed)
+			}
+
+			if kr.PublicHex() != tt.public {
+				t.Errorf("Invalid public key, expected %v, got %v", tt.public, kr.PublicHex())
+			}
+
+			ss58, err := kr.SS58Address()
+
+			if err != nil {
+				t.Fatalf("Error getting SS58 Address: %v", err)
+			}
+
+			if ss58 != tt.ss58 {
+				t.Errorf("Invalid SS58 Address, expected %v, got %v", tt.ss58, ss58)
+			}
+		})
+	}
+}
+
+func TestEd25519Sign(t *testing.T) {
+
+	for _, tt := range ed25519Tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kr, err := Ed25519FromURI(tt.suri, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error generating key ring: %v", err)
+			}
+
+			sig, err := kr.Sign([]byte("test message"))
+
+			if err != nil {
+				t.Fatalf("Error signing message: %v", err)
+			}
+
+			if hex.EncodeToString(sig[:]) != tt.sig {
+				t.Errorf("Invalid signature, expected %v, got %x", tt.sig, sig)
+			}
+		})
+	}
+}
+
+func TestEd25519Verify(t *testing.T) {
+
+	for _, tt := range ed25519Tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kr, err := Ed25519FromURI(tt.ss58, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error generating key ring: %v", err)
+			}
+
+			if kr.PublicHex() != tt.public {
+				t.Errorf("Invalid public key, expected %v, got %v", tt.public, kr.PublicHex())
+			}
+
+			sig, err := hex.DecodeString(tt.sig)
+
+			if err != nil {
+				t.Fatalf("Invalid hex decode: %v", err)
+			}
+
+			var sigB [64]byte
+			copy(sigB[:], sig)
+
+			if !kr.Verify([]byte("test message"), sigB) {
+				t.Errorf("Error signature does not verify")
+			}
+
+			if kr.Verify([]byte("other message"), sigB) {
+				t.Errorf("Error signature verified for wrong message")
+			}
+
+			if _, err := kr.Sign([]byte("test message")); err != ErrNoSecretKey {
+				t.Errorf("Expected no secret key error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestEd25519FromURIInvalid(t *testing.T) {
+
+	tests := []struct {
+		name string
+		suri string
+		err  error
+	}{
+		{
+			name: "Soft Path",
+			suri: "zebra extra skill occur rose muscle reveal robust cigar tilt jungle coral/john",
+			err:  ErrSoftDerivation,
+		},
+		{
+			name: "Mixed Path",
+			suri: "zebra extra skill occur rose muscle reveal robust cigar tilt jungle coral//john/1",
+			err:  ErrSoftDerivation,
+		},
+		{
+			name: "SS58 Public with Path",
+			suri: "5FA9nQDVg267DEd8m1ZypXLBnvN7SFxYwV7ndqSYGiN9TTpu//john",
+			err:  ErrPublicDerivation,
+		},
+		{
+			name: "Long Secret Seed",
+			suri: "0x7590d644baa64600735ab927b6c353b9594a2cf42fe4d57c2d0e639615b37a6a4993f62e38b81c50688a92ab9b656228a9fd9648f152bb7f41a14b7eaa1c3045",
+			err:  ErrInvalidByteLength,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Ed25519FromURI(tt.suri, NetSubstrate{})
+
+			if err != tt.err {
+				t.Errorf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
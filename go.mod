@@ -4,8 +4,11 @@ go 1.14
 
 require (
 	github.com/ChainSafe/go-schnorrkel v1.0.0
-	github.com/cosmos/go-bip39 v0.0.0-20200817134856-d632e0d11689
+	github.com/cosmos/go-bip39 v0.0.0-20200817134856-d632e0d11689 // indirect
 	github.com/decred/base58 v1.0.3
+	github.com/decred/dcrd/dcrec/secp256k1/v3 v3.0.0
 	github.com/gtank/merlin v0.1.1
+	github.com/tyler-smith/go-bip39 v1.1.0
 	golang.org/x/crypto v0.0.0-20201016220609-9e8e0b390897
+	golang.org/x/text v0.3.7
 )
@@ -0,0 +1,456 @@
+package srkeyring
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+
+	sr25519 "github.com/ChainSafe/go-schnorrkel"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// jsonVersion is the polkadot-js encrypted JSON format version supported
+	jsonVersion = "3"
+
+	// jsonSaltLength is the length of the random scrypt salt
+	jsonSaltLength = 32
+	// jsonScryptLength is the length of the scrypt salt and parameters
+	// prefixed to the encoded data
+	jsonScryptLength = jsonSaltLength + 3*4
+	// jsonNonceLength is the length of the xsalsa20-poly1305 nonce
+	jsonNonceLength = 24
+
+	// pkcs8Length is the length of the PKCS8 encoded sr25519 key pair
+	pkcs8Length = 117
+)
+
+var (
+	// pkcs8Header is the PKCS8 header prefixed to the secret key
+	pkcs8Header = []byte{48, 83, 2, 1, 1, 48, 5, 6, 3, 43, 101, 112, 4, 34, 4, 32}
+	// pkcs8Divider separates the secret key and public key in PKCS8 encoding
+	pkcs8Divider = []byte{161, 35, 3, 33, 0}
+
+	// jsonContent is the encoding content of an sr25519 key pair
+	jsonContent = []string{"pkcs8", "sr25519"}
+	// jsonType is the encryption types used for encoding
+	jsonType = []string{"scrypt", "xsalsa20-poly1305"}
+
+	// defaultScryptParams are the scrypt parameters used by polkadot-js
+	defaultScryptParams = scryptParams{N: 1 << 15, P: 1, R: 8}
+
+	// allowedScryptParams are the scrypt parameters accepted when decoding
+	// as limited by polkadot-js
+	allowedScryptParams = []scryptParams{
+		{N: 1 << 13, P: 10, R: 8},
+		{N: 1 << 14, P: 5, R: 8},
+		{N: 1 << 15, P: 3, R: 8},
+		{N: 1 << 15, P: 1, R: 8},
+		{N: 1 << 16, P: 2, R: 8},
+		{N: 1 << 17, P: 1, R: 8},
+	}
+
+	ErrUnsupportedEncoding = errors.New("Unsupported encrypted JSON encoding")
+	ErrInvalidScryptParams = errors.New("Invalid scrypt parameters in encrypted JSON")
+	ErrDecryptFailed       = errors.New("Unable to decrypt JSON, passphrase is incorrect")
+	ErrInvalidPKCS8        = errors.New("Invalid PKCS8 encoded key pair")
+	ErrKeyPairMismatch     = errors.New("Public key does not match the secret key")
+	ErrAddressMismatch     = errors.New("Address does not match the public key")
+)
+
+// scryptParams are the cost parameters of the scrypt key derivation
+type scryptParams struct {
+	N uint32
+	P uint32
+	R uint32
+}
+
+// JSONEncoding describes the encoding of the encrypted data
+type JSONEncoding struct {
+	// Content is the content type of the encrypted data
+	Content []string `json:"content"`
+	// Type is the key derivation and encryption types used
+	Type []string `json:"type"`
+	// Version is the encoding format version
+	Version string `json:"version"`
+}
+
+// EncryptedJSON is an account exported in the polkadot-js encrypted JSON format
+type EncryptedJSON struct {
+	// Encoded is the base64 encoded scrypt parameters and encrypted key pair
+	Encoded string `json:"encoded"`
+	// Encoding describes how the key pair is encoded
+	Encoding JSONEncoding `json:"encoding"`
+	// Address is the SS58 address of the account
+	Address string `json:"address"`
+	// Meta is the account meta data such as name and genesisHash
+	Meta map[string]interface{} `json:"meta"`
+}
+
+// ParseJSON parses the polkadot-js encrypted JSON without decrypting it
+func ParseJSON(data []byte) (*EncryptedJSON, error) {
+
+	ej := new(EncryptedJSON)
+
+	if err := json.Unmarshal(data, ej); err != nil {
+		return nil, err
+	}
+
+	return ej, nil
+}
+
+// FromJSON returns a KeyRing from the polkadot-js encrypted JSON decrypted
+// with the passphrase
+func FromJSON(data []byte, passphrase string, net Network) (*KeyRing, error) {
+
+	ej, err := ParseJSON(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ej.Decrypt(passphrase, net)
+}
+
+// Decrypt returns the KeyRing of the encrypted JSON decrypted with the
+// passphrase
+func (e *EncryptedJSON) Decrypt(passphrase string, net Network) (*KeyRing, error) {
+
+	if !equalStrings(e.Encoding.Content, jsonContent) {
+		return nil, ErrUnsupportedEncoding
+	}
+
+	pkcs8, err := decryptJSON(e.Encoded, e.Encoding, passphrase)
+
+	if err != nil {
+		return nil, err
+	}
+
+	kr, err := decodePKCS8(pkcs8, net)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if e.Address != "" {
+		raw, _, err := DecodeAnySS58Address(e.Address, SS58Checksum)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if raw != kr.Public() {
+			return nil, ErrAddressMismatch
+		}
+	}
+
+	return kr, nil
+}
+
+// EncryptJSON returns the KeyRing encrypted with the passphrase in the
+// polkadot-js encrypted JSON format.  meta is the account meta data to include
+// and may be nil
+func (k *KeyRing) EncryptJSON(passphrase string, meta map[string]interface{}) (*EncryptedJSON, error) {
+
+	pkcs8, err := k.encodePKCS8()
+
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := encryptJSON(rand.Reader, pkcs8, passphrase, defaultScryptParams)
+
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := k.SS58Address()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if meta == nil {
+		meta = make(map[string]interface{})
+	}
+
+	ej := &EncryptedJSON{
+		Encoded: encoded,
+		Encoding: JSONEncoding{
+			Content: append([]string{}, jsonContent...),
+			Type:    append([]string{}, jsonType...),
+			Version: jsonVersion,
+		},
+		Address: addr,
+		Meta:    meta,
+	}
+
+	return ej, nil
+}
+
+// ExportJSON returns the KeyRing encrypted with the passphrase and marshalled
+// in the polkadot-js encrypted JSON format
+func (k *KeyRing) ExportJSON(passphrase string, meta map[string]interface{}) ([]byte, error) {
+
+	ej, err := k.EncryptJSON(passphrase, meta)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(ej)
+}
+
+// encodePKCS8 returns the key pair PKCS8 encoded with the secret key in the
+// ed25519 format used by polkadot-js
+func (k *KeyRing) encodePKCS8() ([]byte, error) {
+
+	if !k.hasSecret {
+		return nil, ErrNoSecretKey
+	}
+
+	nonce, err := k.secretNonce()
+
+	if err != nil {
+		return nil, err
+	}
+
+	key := k.secret.Encode()
+	pub := k.Public()
+
+	buf := make([]byte, 0, pkcs8Length)
+	buf = append(buf, pkcs8Header...)
+	buf = append(buf, multiplyScalarByCofactor(key[:])...)
+	buf = append(buf, nonce[:]...)
+	buf = append(buf, pkcs8Divider...)
+	buf = append(buf, pub[:]...)
+
+	return buf, nil
+}
+
+// secretNonce returns the signing nonce of the secret key.  go-schnorrkel
+// does not expose the nonce so it is recalculated from the seed when the
+// secret key was expanded from it, otherwise a random nonce is used
+func (k *KeyRing) secretNonce() ([32]byte, error) {
+
+	var nonce [32]byte
+
+	if k.hasNonce {
+		return k.nonce, nil
+	}
+
+	if seed, err := k.Seed(); err == nil {
+		ms, err := sr25519.NewMiniSecretKeyFromRaw(seed)
+
+		if err == nil && ms.ExpandEd25519().Encode() == k.secret.Encode() {
+			h := sha512.Sum512(seed[:])
+			copy(nonce[:], h[32:])
+			return nonce, nil
+		}
+	}
+
+	_, err := rand.Read(nonce[:])
+
+	return nonce, err
+}
+
+// decodePKCS8 returns a KeyRing from the PKCS8 encoded key pair
+func decodePKCS8(b []byte, net Network) (*KeyRing, error) {
+
+	if len(b) != pkcs8Length ||
+		!bytes.HasPrefix(b, pkcs8Header) ||
+		!bytes.Equal(b[80:85], pkcs8Divider) {
+		return nil, ErrInvalidPKCS8
+	}
+
+	var key, nonce, pub [32]byte
+
+	copy(key[:], divideScalarByCofactor(b[16:48]))
+	copy(nonce[:], b[48:80])
+	copy(pub[:], b[85:])
+
+	secret := sr25519.NewSecretKey(key, nonce)
+	pk, err := secret.Public()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if pk.Encode() != pub {
+		return nil, ErrKeyPairMismatch
+	}
+
+	kr := &KeyRing{
+		secret:    secret,
+		pub:       pk,
+		hasSecret: true,
+		suri: &SecretURI{
+			Network: net,
+			Type:    RawSecretKey,
+		},
+		nonce:    nonce,
+		hasNonce: true,
+	}
+
+	return kr, nil
+}
+
+// encryptJSON encrypts the data with a key derived from the passphrase and
+// returns it base64 encoded in the format
+// salt || N || p || r || nonce || ciphertext
+func encryptJSON(rnd io.Reader, data []byte, passphrase string, params scryptParams) (string, error) {
+
+	buf := make([]byte, jsonScryptLength+jsonNonceLength)
+
+	if _, err := io.ReadFull(rnd, buf[:jsonSaltLength]); err != nil {
+		return "", err
+	}
+
+	binary.LittleEndian.PutUint32(buf[32:36], params.N)
+	binary.LittleEndian.PutUint32(buf[36:40], params.P)
+	binary.LittleEndian.PutUint32(buf[40:44], params.R)
+
+	if _, err := io.ReadFull(rnd, buf[jsonScryptLength:]); err != nil {
+		return "", err
+	}
+
+	key, err := scryptKey(passphrase, buf[:jsonSaltLength], params)
+
+	if err != nil {
+		return "", err
+	}
+
+	var nonce [jsonNonceLength]byte
+	copy(nonce[:], buf[jsonScryptLength:])
+
+	buf = secretbox.Seal(buf, data, &nonce, &key)
+
+	return base64.StdEncoding.EncodeToString(buf), nil
+}
+
+// decryptJSON decrypts the base64 encoded data with a key derived from the
+// passphrase
+func decryptJSON(encoded string, enc JSONEncoding, passphrase string) ([]byte, error) {
+
+	if enc.Version != jsonVersion || !equalStrings(enc.Type, jsonType) {
+		return nil, ErrUnsupportedEncoding
+	}
+
+	buf, err := base64.StdEncoding.DecodeString(encoded)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(buf) < jsonScryptLength+jsonNonceLength+secretbox.Overhead {
+		return nil, ErrInvalidByteLength
+	}
+
+	params := scryptParams{
+		N: binary.LittleEndian.Uint32(buf[32:36]),
+		P: binary.LittleEndian.Uint32(buf[36:40]),
+		R: binary.LittleEndian.Uint32(buf[40:44]),
+	}
+
+	if !params.allowed() {
+		return nil, ErrInvalidScryptParams
+	}
+
+	key, err := scryptKey(passphrase, buf[:jsonSaltLength], params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var nonce [jsonNonceLength]byte
+	copy(nonce[:], buf[jsonScryptLength:])
+
+	data, ok := secretbox.Open(nil, buf[jsonScryptLength+jsonNonceLength:], &nonce, &key)
+
+	if !ok {
+		return nil, ErrDecryptFailed
+	}
+
+	return data, nil
+}
+
+// allowed returns true if the scrypt parameters are accepted by polkadot-js
+func (p scryptParams) allowed() bool {
+	for _, a := range allowedScryptParams {
+		if p == a {
+			return true
+		}
+	}
+
+	return false
+}
+
+// scryptKey derives the secretbox key from the passphrase, only the first 32
+// bytes of the 64 byte scrypt output are used
+func scryptKey(passphrase string, salt []byte, params scryptParams) ([32]byte, error) {
+
+	var key [32]byte
+
+	b, err := scrypt.Key([]byte(passphrase), salt, int(params.N), int(params.R),
+		int(params.P), 64)
+
+	if err != nil {
+		return key, err
+	}
+
+	copy(key[:], b)
+
+	return key, nil
+}
+
+// multiplyScalarByCofactor returns the little endian scalar multiplied by the
+// curve cofactor of 8, converting an sr25519 secret key to the ed25519 format
+// used by polkadot-js
+func multiplyScalarByCofactor(s []byte) []byte {
+	res := make([]byte, len(s))
+	high := byte(0)
+
+	for i, b := range s {
+		r := b & 0xe0
+		res[i] = b<<3 + high
+		high = r >> 5
+	}
+
+	return res
+}
+
+// divideScalarByCofactor returns the little endian scalar divided by the curve
+// cofactor of 8, converting an ed25519 format secret key to sr25519
+func divideScalarByCofactor(s []byte) []byte {
+	res := make([]byte, len(s))
+	low := byte(0)
+
+	for i := len(s) - 1; i >= 0; i-- {
+		r := s[i] & 0x07
+		res[i] = s[i]>>3 + low
+		low = r << 5
+	}
+
+	return res
+}
+
+// equalStrings returns true if both string slices hold the same values
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,415 @@
+package srkeyring
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+)
+
+// Note: the secret key of Alice matches the ed25519 format secret key used by
+// the polkadot-js keyring testing pairs
+
+func TestEncodePKCS8(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		suri   string
+		secret string
+	}{
+		{
+			name:   "Dev Alice",
+			suri:   devPhrase + "//Alice",
+			secret: "98319d4ff8a9508c4bb0cf0b5a78d760a0b2082c02775e6e82370816fedfff48925a225d97aa00682d6a59b95b18780c10d7032336e88f3442b42361f4a66011",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kr, err := FromURI(tt.suri, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error generating key ring: %v", err)
+			}
+
+			b, err := kr.encodePKCS8()
+
+			if err != nil {
+				t.Fatalf("Error encoding PKCS8: %v", err)
+			}
+
+			if len(b) != pkcs8Length {
+				t.Fatalf("Invalid PKCS8 length, expected %d, got %d", pkcs8Length, len(b))
+			}
+
+			if hex.EncodeToString(b[16:80]) != tt.secret {
+				t.Errorf("Invalid secret key, expected %v, got %x", tt.secret, b[16:80])
+			}
+
+			pub := kr.Public()
+
+			if !bytes.Equal(b[85:], pub[:]) {
+				t.Errorf("Invalid public key, expected %x, got %x", pub, b[85:])
+			}
+		})
+	}
+}
+
+func TestExportJSON(t *testing.T) {
+
+	tests := []struct {
+		name string
+		suri string
+		net  Network
+		meta map[string]interface{}
+	}{
+		{
+			name: "Mnemonic",
+			suri: "zebra extra skill occur rose muscle reveal robust cigar tilt jungle coral",
+			net:  NetSubstrate{},
+			meta: map[string]interface{}{"name": "zebra"},
+		},
+		{
+			name: "Mnemonic with Hard Path",
+			suri: devPhrase + "//Alice",
+			net:  netWide{prefix: 0},
+			meta: map[string]interface{}{"name": "Alice", "whenCreated": float64(1600000000000)},
+		},
+		{
+			name: "Mnemonic with Soft Path",
+			suri: devPhrase + "//Alice/0",
+			net:  netWide{prefix: 2},
+		},
+		{
+			name: "Secret Seed",
+			suri: "0x7202a4eba69bb283e8e9a3f5f6f0fc64bb02e6d20fb4b6bde13caec148f2cca7",
+			net:  NetSubstrate{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kr, err := FromURI(tt.suri, tt.net)
+
+			if err != nil {
+				t.Fatalf("Error generating key ring: %v", err)
+			}
+
+			data, err := kr.ExportJSON("pass1234", tt.meta)
+
+			if err != nil {
+				t.Fatalf("Error exporting JSON: %v", err)
+			}
+
+			ej, err := ParseJSON(data)
+
+			if err != nil {
+				t.Fatalf("Error parsing JSON: %v", err)
+			}
+
+			ss58, _ := kr.SS58Address()
+
+			if ej.Address != ss58 {
+				t.Errorf("Invalid address, expected %v, got %v", ss58, ej.Address)
+			}
+
+			if ej.Encoding.Version != "3" ||
+				!equalStrings(ej.Encoding.Content, []string{"pkcs8", "sr25519"}) ||
+				!equalStrings(ej.Encoding.Type, []string{"scrypt", "xsalsa20-poly1305"}) {
+				t.Errorf("Invalid encoding, got %+v", ej.Encoding)
+			}
+
+			if len(ej.Meta) != len(tt.meta) {
+				t.Errorf("Invalid meta, expected %v, got %v", tt.meta, ej.Meta)
+			}
+
+			for key, val := range tt.meta {
+				if ej.Meta[key] != val {
+					t.Errorf("Invalid meta %v, expected %v, got %v", key, val, ej.Meta[key])
+				}
+			}
+
+			raw, err := base64.StdEncoding.DecodeString(ej.Encoded)
+
+			if err != nil {
+				t.Fatalf("Error decoding base64: %v", err)
+			}
+
+			// salt, scrypt params, nonce, PKCS8 and poly1305 tag
+			if len(raw) != 44+24+117+16 {
+				t.Errorf("Invalid encoded length, got %d", len(raw))
+			}
+
+			kr2, err := FromJSON(data, "pass1234", tt.net)
+
+			if err != nil {
+				t.Fatalf("Error importing JSON: %v", err)
+			}
+
+			if kr2.PublicHex() != kr.PublicHex() {
+				t.Errorf("Invalid public key, expected %v, got %v", kr.PublicHex(), kr2.PublicHex())
+			}
+
+			msg := []byte("test message")
+			sig, err := kr2.Sign(kr2.SigningContext(msg))
+
+			if err != nil {
+				t.Fatalf("Error signing message: %v", err)
+			}
+
+			if !kr.Verify(kr.SigningContext(msg), sig) {
+				t.Errorf("Error signature does not verify")
+			}
+
+			if _, err := kr2.Seed(); err != ErrSeedNotAvailable {
+				t.Errorf("Expected seed not available error, got %v", err)
+			}
+
+			// exporting the imported key ring keeps the secret key and nonce
+			data2, err := kr2.ExportJSON("pass1234", nil)
+
+			if err != nil {
+				t.Fatalf("Error exporting JSON: %v", err)
+			}
+
+			b1, err := decryptJSON(ej.Encoded, ej.Encoding, "pass1234")
+
+			if err != nil {
+				t.Fatalf("Error decrypting JSON: %v", err)
+			}
+
+			ej2, err := ParseJSON(data2)
+
+			if err != nil {
+				t.Fatalf("Error parsing JSON: %v", err)
+			}
+
+			b2, err := decryptJSON(ej2.Encoded, ej2.Encoding, "pass1234")
+
+			if err != nil {
+				t.Fatalf("Error decrypting JSON: %v", err)
+			}
+
+			if !bytes.Equal(b1, b2) {
+				t.Errorf("Invalid PKCS8, expected %x, got %x", b1, b2)
+			}
+		})
+	}
+}
+
+func TestFromJSONInvalid(t *testing.T) {
+
+	kr, err := FromURI(devPhrase+"//Alice", NetSubstrate{})
+
+	if err != nil {
+		t.Fatalf("Error generating key ring: %v", err)
+	}
+
+	data, err := kr.ExportJSON("pass1234", nil)
+
+	if err != nil {
+		t.Fatalf("Error exporting JSON: %v", err)
+	}
+
+	bob, err := FromURI(devPhrase+"//Bob", NetSubstrate{})
+
+	if err != nil {
+		t.Fatalf("Error generating key ring: %v", err)
+	}
+
+	bobAddr, _ := bob.SS58Address()
+
+	tests := []struct {
+		name   string
+		pass   string
+		modify func(ej *EncryptedJSON)
+		err    error
+	}{
+		{
+			name:   "Wrong Passphrase",
+			pass:   "wrong",
+			modify: func(ej *EncryptedJSON) {},
+			err:    ErrDecryptFailed,
+		},
+		{
+			name: "Address Mismatch",
+			pass: "pass1234",
+			modify: func(ej *EncryptedJSON) {
+				ej.Address = bobAddr
+			},
+			err: ErrAddressMismatch,
+		},
+		{
+			name: "Unsupported Content",
+			pass: "pass1234",
+			modify: func(ej *EncryptedJSON) {
+				ej.Encoding.Content = []string{"pkcs8", "ed25519"}
+			},
+			err: ErrUnsupportedEncoding,
+		},
+		{
+			name: "Unsupported Version",
+			pass: "pass1234",
+			modify: func(ej *EncryptedJSON) {
+				ej.Encoding.Version = "2"
+			},
+			err: ErrUnsupportedEncoding,
+		},
+		{
+			name: "Invalid Scrypt Params",
+			pass: "pass1234",
+			modify: func(ej *EncryptedJSON) {
+				raw, _ := base64.StdEncoding.DecodeString(ej.Encoded)
+				binary.LittleEndian.PutUint32(raw[32:36], 1<<20)
+				ej.Encoded = base64.StdEncoding.EncodeToString(raw)
+			},
+			err: ErrInvalidScryptParams,
+		},
+		{
+			name: "Tampered Ciphertext",
+			pass: "pass1234",
+			modify: func(ej *EncryptedJSON) {
+				raw, _ := base64.StdEncoding.DecodeString(ej.Encoded)
+				raw[len(raw)-1] ^= 0xff
+				ej.Encoded = base64.StdEncoding.EncodeToString(raw)
+			},
+			err: ErrDecryptFailed,
+		},
+		{
+			name: "Truncated",
+			pass: "pass1234",
+			modify: func(ej *EncryptedJSON) {
+				ej.Encoded = base64.StdEncoding.EncodeToString([]byte("short"))
+			},
+			err: ErrInvalidByteLength,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ej, err := ParseJSON(data)
+
+			if err != nil {
+				t.Fatalf("Error parsing JSON: %v", err)
+			}
+
+			tt.modify(ej)
+
+			b, err := json.Marshal(ej)
+
+			if err != nil {
+				t.Fatalf("Error marshalling JSON: %v", err)
+			}
+
+			if _, err := FromJSON(b, tt.pass, NetSubstrate{}); err != tt.err {
+				t.Errorf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestDecodePKCS8Invalid(t *testing.T) {
+
+	kr, err := FromURI(devPhrase+"//Alice", NetSubstrate{})
+
+	if err != nil {
+		t.Fatalf("Error generating key ring: %v", err)
+	}
+
+	valid, err := kr.encodePKCS8()
+
+	if err != nil {
+		t.Fatalf("Error encoding PKCS8: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(b []byte) []byte
+		err    error
+	}{
+		{
+			name:   "Short",
+			modify: func(b []byte) []byte { return b[:100] },
+			err:    ErrInvalidPKCS8,
+		},
+		{
+			name:   "Invalid Header",
+			modify: func(b []byte) []byte { b[0] = 0; return b },
+			err:    ErrInvalidPKCS8,
+		},
+		{
+			name:   "Invalid Divider",
+			modify: func(b []byte) []byte { b[80] = 0; return b },
+			err:    ErrInvalidPKCS8,
+		},
+		{
+			name:   "Public Key Mismatch",
+			modify: func(b []byte) []byte { b[100] ^= 0xff; return b },
+			err:    ErrKeyPairMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := tt.modify(append([]byte{}, valid...))
+
+			if _, err := decodePKCS8(b, NetSubstrate{}); err != tt.err {
+				t.Errorf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestScalarCofactor(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		scalar string
+	}{
+		{
+			name:   "Zero",
+			scalar: "0000000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name:   "Dev Alice",
+			scalar: "33a6f3093f158a7109f679410bef1a0c54168145e0cecb4df006c1c2fffb1f09",
+		},
+		{
+			name:   "Max",
+			scalar: "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1f",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := hex.DecodeString(tt.scalar)
+
+			if err != nil {
+				t.Fatalf("Invalid hex decode: %v", err)
+			}
+
+			res := divideScalarByCofactor(multiplyScalarByCofactor(s))
+
+			if !bytes.Equal(res, s) {
+				t.Errorf("Invalid scalar, expected %x, got %x", s, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package srkeyring
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+)
+
+var (
+	// jsonBatchContent is the encoding content of a multi-account batch
+	jsonBatchContent = []string{"batch-pkcs8"}
+
+	ErrBatchMismatch = errors.New("Batch accounts do not match the encrypted accounts")
+)
+
+// JSONAccount is a KeyRing with its polkadot-js account meta data
+type JSONAccount struct {
+	// KeyRing is the account key pair
+	KeyRing *KeyRing
+	// Meta is the account meta data such as name and genesisHash
+	Meta map[string]interface{}
+}
+
+// BatchAccount is the unencrypted address and meta data of an account listed
+// in a batch export
+type BatchAccount struct {
+	// Address is the SS58 address of the account
+	Address string `json:"address"`
+	// Meta is the account meta data
+	Meta map[string]interface{} `json:"meta"`
+}
+
+// BatchJSON is a set of accounts exported in the polkadot-js "Export all
+// accounts" encrypted batch JSON format
+type BatchJSON struct {
+	// Encoded is the base64 encoded scrypt parameters and the encrypted JSON
+	// array of each account's EncryptedJSON
+	Encoded string `json:"encoded"`
+	// Encoding describes how the accounts are encoded
+	Encoding JSONEncoding `json:"encoding"`
+	// Accounts lists the address and meta data of each account
+	Accounts []BatchAccount `json:"accounts"`
+}
+
+// ParseBatchJSON parses the polkadot-js batch JSON without decrypting it
+func ParseBatchJSON(data []byte) (*BatchJSON, error) {
+
+	bj := new(BatchJSON)
+
+	if err := json.Unmarshal(data, bj); err != nil {
+		return nil, err
+	}
+
+	return bj, nil
+}
+
+// FromBatchJSON returns the accounts of the polkadot-js batch JSON decrypted
+// with the passphrase, where every account has the same passphrase as the
+// batch, see BatchJSON.DecryptAccounts for accounts with their own passphrases
+func FromBatchJSON(data []byte, passphrase string, net Network) ([]JSONAccount, error) {
+
+	bj, err := ParseBatchJSON(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bj.Decrypt(passphrase, net)
+}
+
+// Decrypt returns the accounts of the batch JSON decrypted with the
+// passphrase.  This is a convenience for batches where every account was
+// encrypted with the same passphrase as the batch, use DecryptAccounts when
+// the accounts have their own passphrases
+func (b *BatchJSON) Decrypt(passphrase string, net Network) ([]JSONAccount, error) {
+
+	list, err := b.DecryptAccounts(passphrase)
+
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]JSONAccount, 0, len(list))
+
+	for i := range list {
+
+		kr, err := list[i].Decrypt(passphrase, net)
+
+		if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, JSONAccount{
+			KeyRing: kr,
+			Meta:    list[i].Meta,
+		})
+	}
+
+	return accounts, nil
+}
+
+// DecryptAccounts returns the encrypted JSON of each account in the batch
+// JSON decrypted with the batch passphrase.  As with polkadot-js each account
+// remains encrypted with its own passphrase and is decrypted separately with
+// EncryptedJSON.Decrypt
+func (b *BatchJSON) DecryptAccounts(passphrase string) ([]EncryptedJSON, error) {
+
+	if !equalStrings(b.Encoding.Content, jsonBatchContent) {
+		return nil, ErrUnsupportedEncoding
+	}
+
+	data, err := decryptJSON(b.Encoded, b.Encoding, passphrase)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var list []EncryptedJSON
+
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+
+	if len(b.Accounts) > 0 && len(b.Accounts) != len(list) {
+		return nil, ErrBatchMismatch
+	}
+
+	for i := range list {
+		if len(b.Accounts) > 0 && b.Accounts[i].Address != list[i].Address {
+			return nil, ErrBatchMismatch
+		}
+	}
+
+	return list, nil
+}
+
+// EncryptBatchJSON returns the accounts in the polkadot-js batch JSON format
+// with each account and the batch encrypted with the same passphrase, use
+// EncryptBatchJSONAccounts to keep a separate passphrase for each account
+func EncryptBatchJSON(accounts []JSONAccount, passphrase string) (*BatchJSON, error) {
+
+	list := make([]*EncryptedJSON, 0, len(accounts))
+
+	for _, acc := range accounts {
+
+		ej, err := acc.KeyRing.EncryptJSON(passphrase, acc.Meta)
+
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, ej)
+	}
+
+	return EncryptBatchJSONAccounts(list, passphrase)
+}
+
+// EncryptBatchJSONAccounts returns the already encrypted accounts in the
+// polkadot-js batch JSON format with the batch encrypted with the passphrase.
+// The accounts are included unchanged so keep their own passphrases, the same
+// as the polkadot-js "Export all accounts" option
+func EncryptBatchJSONAccounts(list []*EncryptedJSON, passphrase string) (*BatchJSON, error) {
+
+	summary := make([]BatchAccount, 0, len(list))
+
+	for _, ej := range list {
+		summary = append(summary, BatchAccount{
+			Address: ej.Address,
+			Meta:    ej.Meta,
+		})
+	}
+
+	data, err := json.Marshal(list)
+
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := encryptJSON(rand.Reader, data, passphrase, defaultScryptParams)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bj := &BatchJSON{
+		Encoded: encoded,
+		Encoding: JSONEncoding{
+			Content: append([]string{}, jsonBatchContent...),
+			Type:    append([]string{}, jsonType...),
+			Version: jsonVersion,
+		},
+		Accounts: summary,
+	}
+
+	return bj, nil
+}
+
+// ExportBatchJSON returns the accounts and batch encrypted with the same
+// passphrase and marshalled in the polkadot-js batch JSON format
+func ExportBatchJSON(accounts []JSONAccount, passphrase string) ([]byte, error) {
+
+	bj, err := EncryptBatchJSON(accounts, passphrase)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(bj)
+}
@@ -0,0 +1,299 @@
+package srkeyring
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestExportBatchJSON(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		suris []string
+	}{
+		{
+			name:  "Empty",
+			suris: []string{},
+		},
+		{
+			name:  "Single Account",
+			suris: []string{devPhrase + "//Alice"},
+		},
+		{
+			name: "Multiple Accounts",
+			suris: []string{
+				devPhrase + "//Alice",
+				devPhrase + "//Bob",
+				"zebra extra skill occur rose muscle reveal robust cigar tilt jungle coral",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var accounts []JSONAccount
+
+			for i, suri := range tt.suris {
+				kr, err := FromURI(suri, NetSubstrate{})
+
+				if err != nil {
+					t.Fatalf("Error generating key ring: %v", err)
+				}
+
+				accounts = append(accounts, JSONAccount{
+					KeyRing: kr,
+					Meta:    map[string]interface{}{"name": suri, "index": float64(i)},
+				})
+			}
+
+			data, err := ExportBatchJSON(accounts, "pass1234")
+
+			if err != nil {
+				t.Fatalf("Error exporting batch JSON: %v", err)
+			}
+
+			bj, err := ParseBatchJSON(data)
+
+			if err != nil {
+				t.Fatalf("Error parsing batch JSON: %v", err)
+			}
+
+			if !equalStrings(bj.Encoding.Content, []string{"batch-pkcs8"}) {
+				t.Errorf("Invalid encoding content, got %v", bj.Encoding.Content)
+			}
+
+			if len(bj.Accounts) != len(accounts) {
+				t.Fatalf("Invalid account count, expected %d, got %d", len(accounts), len(bj.Accounts))
+			}
+
+			for i, acc := range bj.Accounts {
+				ss58, _ := accounts[i].KeyRing.SS58Address()
+
+				if acc.Address != ss58 {
+					t.Errorf("Invalid address, expected %v, got %v", ss58, acc.Address)
+				}
+
+				if acc.Meta["name"] != tt.suris[i] {
+					t.Errorf("Invalid meta name, expected %v, got %v", tt.suris[i], acc.Meta["name"])
+				}
+			}
+
+			res, err := FromBatchJSON(data, "pass1234", NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error importing batch JSON: %v", err)
+			}
+
+			if len(res) != len(accounts) {
+				t.Fatalf("Invalid account count, expected %d, got %d", len(accounts), len(res))
+			}
+
+			for i, acc := range res {
+				if acc.KeyRing.PublicHex() != accounts[i].KeyRing.PublicHex() {
+					t.Errorf("Invalid public key, expected %v, got %v",
+						accounts[i].KeyRing.PublicHex(), acc.KeyRing.PublicHex())
+				}
+
+				if acc.Meta["index"] != float64(i) {
+					t.Errorf("Invalid meta index, expected %v, got %v", i, acc.Meta["index"])
+				}
+			}
+		})
+	}
+}
+
+func TestFromBatchJSONInvalid(t *testing.T) {
+
+	var accounts []JSONAccount
+
+	for _, name := range []string{"//Alice", "//Bob"} {
+		kr, err := FromURI(devPhrase+name, NetSubstrate{})
+
+		if err != nil {
+			t.Fatalf("Error generating key ring: %v", err)
+		}
+
+		accounts = append(accounts, JSONAccount{KeyRing: kr})
+	}
+
+	data, err := ExportBatchJSON(accounts, "pass1234")
+
+	if err != nil {
+		t.Fatalf("Error exporting batch JSON: %v", err)
+	}
+
+	single, err := accounts[0].KeyRing.ExportJSON("pass1234", nil)
+
+	if err != nil {
+		t.Fatalf("Error exporting JSON: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		data   []byte
+		pass   string
+		modify func(bj *BatchJSON)
+		err    error
+	}{
+		{
+			name:   "Wrong Passphrase",
+			data:   data,
+			pass:   "wrong",
+			modify: func(bj *BatchJSON) {},
+			err:    ErrDecryptFailed,
+		},
+		{
+			name: "Account Count Mismatch",
+			data: data,
+			pass: "pass1234",
+			modify: func(bj *BatchJSON) {
+				bj.Accounts = bj.Accounts[:1]
+			},
+			err: ErrBatchMismatch,
+		},
+		{
+			name: "Account Address Mismatch",
+			data: data,
+			pass: "pass1234",
+			modify: func(bj *BatchJSON) {
+				bj.Accounts[0], bj.Accounts[1] = bj.Accounts[1], bj.Accounts[0]
+			},
+			err: ErrBatchMismatch,
+		},
+		{
+			name:   "Single Account JSON",
+			data:   single,
+			pass:   "pass1234",
+			modify: func(bj *BatchJSON) {},
+			err:    ErrUnsupportedEncoding,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			bj, err := ParseBatchJSON(tt.data)
+
+			if err != nil {
+				t.Fatalf("Error parsing batch JSON: %v", err)
+			}
+
+			tt.modify(bj)
+
+			b, err := json.Marshal(bj)
+
+			if err != nil {
+				t.Fatalf("Error marshalling JSON: %v", err)
+			}
+
+			if _, err := FromBatchJSON(b, tt.pass, NetSubstrate{}); err != tt.err {
+				t.Errorf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestBatchJSONAccountPassphrases(t *testing.T) {
+
+	tests := []struct {
+		name string
+		suri string
+		pass string
+	}{
+		{
+			name: "Alice",
+			suri: devPhrase + "//Alice",
+			pass: "alice1234",
+		},
+		{
+			name: "Bob",
+			suri: devPhrase + "//Bob",
+			pass: "bob1234",
+		},
+	}
+
+	var list []*EncryptedJSON
+	var keyrings []*KeyRing
+
+	for _, tt := range tests {
+		kr, err := FromURI(tt.suri, NetSubstrate{})
+
+		if err != nil {
+			t.Fatalf("Error generating key ring: %v", err)
+		}
+
+		ej, err := kr.EncryptJSON(tt.pass, map[string]interface{}{"name": tt.name})
+
+		if err != nil {
+			t.Fatalf("Error encrypting JSON: %v", err)
+		}
+
+		list = append(list, ej)
+		keyrings = append(keyrings, kr)
+	}
+
+	bj, err := EncryptBatchJSONAccounts(list, "batch1234")
+
+	if err != nil {
+		t.Fatalf("Error encrypting batch JSON: %v", err)
+	}
+
+	data, err := json.Marshal(bj)
+
+	if err != nil {
+		t.Fatalf("Error marshalling JSON: %v", err)
+	}
+
+	if _, err := FromBatchJSON(data, "batch1234", NetSubstrate{}); err != ErrDecryptFailed {
+		t.Errorf("Invalid error decrypting with batch passphrase, expected %v, got %v",
+			ErrDecryptFailed, err)
+	}
+
+	bj, err = ParseBatchJSON(data)
+
+	if err != nil {
+		t.Fatalf("Error parsing batch JSON: %v", err)
+	}
+
+	if _, err := bj.DecryptAccounts("alice1234"); err != ErrDecryptFailed {
+		t.Errorf("Invalid error decrypting with account passphrase, expected %v, got %v",
+			ErrDecryptFailed, err)
+	}
+
+	res, err := bj.DecryptAccounts("batch1234")
+
+	if err != nil {
+		t.Fatalf("Error decrypting batch accounts: %v", err)
+	}
+
+	if len(res) != len(tests) {
+		t.Fatalf("Invalid account count, expected %d, got %d", len(tests), len(res))
+	}
+
+	for i, tt := range tests {
+		if res[i].Meta["name"] != tt.name {
+			t.Errorf("Invalid meta name, expected %v, got %v", tt.name, res[i].Meta["name"])
+		}
+
+		if _, err := res[i].Decrypt("batch1234", NetSubstrate{}); err != ErrDecryptFailed {
+			t.Errorf("Invalid error decrypting %v with batch passphrase, expected %v, got %v",
+				tt.name, ErrDecryptFailed, err)
+		}
+
+		kr, err := res[i].Decrypt(tt.pass, NetSubstrate{})
+
+		if err != nil {
+			t.Fatalf("Error decrypting %v: %v", tt.name, err)
+		}
+
+		if kr.PublicHex() != keyrings[i].PublicHex() {
+			t.Errorf("Invalid public key for %v, expected %v, got %v",
+				tt.name, keyrings[i].PublicHex(), kr.PublicHex())
+		}
+	}
+}
@@ -2,16 +2,26 @@ package srkeyring
 
 import (
 	"encoding/binary"
+	"errors"
 	"golang.org/x/crypto/blake2b"
+	"regexp"
 	"strconv"
 	"strings"
 )
 
 const junctionIDLen = 32
 
-// junction contains the chaincode for the given path part from a Secret and
+var (
+	// junctionRe is the regular expression for matching a single hard or soft
+	// junction
+	junctionRe = regexp.MustCompile(`^//?[^/]+$`)
+
+	ErrInvalidJunction = errors.New("Junction format is invalid")
+)
+
+// Junction contains the chaincode for the given path part from a Secret and
 // if its a Soft or Hard key junction
-type junction struct {
+type Junction struct {
 	// path is a single part of the Secret URI path
 	path string
 	// chainCode of the path part
@@ -20,10 +30,105 @@ type junction struct {
 	hard bool
 }
 
+// ParseJunction parses a single hard or soft junction such as "//polkadot"
+// or "/0".  Numeric junctions are encoded as a little endian integer and
+// all other junctions as a SCALE encoded string, as done by subkey
+func ParseJunction(str string) (*Junction, error) {
+
+	if !junctionRe.MatchString(str) {
+		return nil, ErrInvalidJunction
+	}
+
+	return newJunction(strings.TrimPrefix(str, "/"))
+}
+
+// NewIndexJunction returns the junction of the numeric index
+func NewIndexJunction(index uint64, hard bool) *Junction {
+
+	j := &Junction{
+		path: strconv.FormatUint(index, 10),
+		hard: hard,
+	}
+
+	binary.LittleEndian.PutUint64(j.chainCode[:8], index)
+
+	return j
+}
+
+// NewStringJunction returns the junction of the string, which is always
+// encoded as a string even when numeric.  The string can not be empty or
+// contain "/".  Note that a numeric string does not format back to a path
+// which parses to the same junction
+func NewStringJunction(str string, hard bool) (*Junction, error) {
+
+	if str == "" || strings.Contains(str, "/") {
+		return nil, ErrInvalidJunction
+	}
+
+	cl, err := compactUint(uint64(len(str)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	j := &Junction{
+		path: str,
+		hard: hard,
+	}
+
+	j.setChainCode(append(cl, str...))
+
+	return j, nil
+}
+
+// NewChainCodeJunction returns the junction of the raw chain code.  As it has
+// no path text it is formatted as the hex encoded chain code, which does not
+// parse back to the same junction
+func NewChainCodeJunction(cc [32]byte, hard bool) *Junction {
+	return &Junction{
+		path:      EncodeHex(cc[:], "0x"),
+		chainCode: cc,
+		hard:      hard,
+	}
+}
+
+// Hard returns true if the junction is a hard derivation
+func (j *Junction) Hard() bool {
+	return j.hard
+}
+
+// ChainCode returns the 32 byte chain code of the junction
+func (j *Junction) ChainCode() [32]byte {
+	return j.chainCode
+}
+
+// String returns the junction formatted as "//<path>" for hard junctions or
+// "/<path>" for soft junctions
+func (j *Junction) String() string {
+	if j.hard {
+		return "//" + j.path
+	}
+
+	return "/" + j.path
+}
+
+// setChainCode sets the chain code from the encoded junction, using its hash
+// when longer than 32 bytes
+func (j *Junction) setChainCode(bc []byte) {
+
+	if len(bc) > junctionIDLen {
+		// if the serialized "part" is longer than 32 bytes then use its hash
+		b := blake2b.Sum256(bc)
+		bc = b[:]
+	}
+
+	copy(j.chainCode[:len(bc)], bc)
+}
+
 // newJunction takes a part of the path and parses it into a junction
-func newJunction(part string) (*junction, error) {
+func newJunction(part string) (*Junction, error) {
 
-	j := &junction{}
+	j := &Junction{}
 
 	if strings.HasPrefix(part, "/") {
 		// hard key
@@ -40,9 +145,11 @@ func newJunction(part string) (*junction, error) {
 
 	} else {
 		// compactUint is parities codec for data serialization used for storing
-		// the length of the "part" of a UTF-8 string which allocates 4 bytes
-		// per character.  In ascii the word "joe" converts to 3*4 = 12 or
-		// "polkadot" is 8*4 = 32.
+		// the length of the "part" in bytes, as a SCALE encoded string is the
+		// compact length of its UTF-8 bytes followed by the bytes.  The part is
+		// used as given without normalization, as done by Substrate, so "joe"
+		// encodes as 3<<2 = 12 followed by the 3 bytes while "ウォレット" of 5
+		// characters is 15 UTF-8 bytes so encodes as 60 followed by the bytes.
 		cl, err := compactUint(uint64(len(part)))
 
 		if err != nil {
@@ -53,14 +160,52 @@ func newJunction(part string) (*junction, error) {
 
 	}
 
-	if len(bc) > junctionIDLen {
-		// if the serialized "part" is longer than 32 bytes then use its hash
-		b := blake2b.Sum256(bc)
-		bc = b[:]
-	}
-
-	copy(j.chainCode[:len(bc)], bc)
+	j.setChainCode(bc)
 	j.path = part
 
 	return j, nil
 }
+
+// DerivationPath is a sequence of hard and soft junctions
+type DerivationPath []*Junction
+
+// ParseDerivationPath parses a derivation path of hard and soft junctions
+// such as "//polkadot/0"
+func ParseDerivationPath(path string) (DerivationPath, error) {
+
+	if !derivePathRe.MatchString(path) {
+		return nil, ErrInvalidDerivePath
+	}
+
+	junctions, err := (&SecretURI{Path: path}).GetJunctions()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return DerivationPath(junctions), nil
+}
+
+// String returns the derivation path formatted as used in a Secret URI
+func (p DerivationPath) String() string {
+
+	var sb strings.Builder
+
+	for _, j := range p {
+		sb.WriteString(j.String())
+	}
+
+	return sb.String()
+}
+
+// AllHard returns true if every junction of the path is hard
+func (p DerivationPath) AllHard() bool {
+
+	for _, j := range p {
+		if !j.hard {
+			return false
+		}
+	}
+
+	return true
+}
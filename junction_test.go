@@ -2,6 +2,7 @@ package srkeyring
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -10,12 +11,12 @@ func TestNewJunction(t *testing.T) {
 	tests := []struct {
 		name     string
 		part     string
-		expected *junction
+		expected *Junction
 	}{
 		{
 			name: "Soft path",
 			part: "joe",
-			expected: &junction{
+			expected: &Junction{
 				path:      "joe",
 				chainCode: [32]byte{12, 106, 111, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
 				hard:      false,
@@ -24,7 +25,7 @@ func TestNewJunction(t *testing.T) {
 		{
 			name: "Hard path",
 			part: "/joe",
-			expected: &junction{
+			expected: &Junction{
 				path:      "joe",
 				chainCode: [32]byte{12, 106, 111, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
 				hard:      true,
@@ -33,7 +34,7 @@ func TestNewJunction(t *testing.T) {
 		{
 			name: "Long path causing hash",
 			part: "Each derived keypair is coupled with a path ( which means it belongs to a certain network), which prevent it to be used in another network",
-			expected: &junction{
+			expected: &Junction{
 				path:      "Each derived keypair is coupled with a path ( which means it belongs to a certain network), which prevent it to be used in another network",
 				chainCode: [32]byte{142, 20, 254, 131, 131, 103, 80, 71, 19, 166, 248, 34, 30, 67, 213, 27, 12, 164, 204, 139, 70, 110, 249, 1, 153, 252, 82, 23, 14, 230, 91, 114},
 				hard:      false,
@@ -58,3 +59,266 @@ func TestNewJunction(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDerivationPath(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		path    string
+		allHard bool
+	}{
+		{
+			name:    "Empty",
+			path:    "",
+			allHard: true,
+		},
+		{
+			name:    "Hard",
+			path:    "//joe//polkadot//0",
+			allHard: true,
+		},
+		{
+			name:    "Soft",
+			path:    "/joe/polkadot/0",
+			allHard: false,
+		},
+		{
+			name:    "Mixed",
+			path:    "//polkadot/0",
+			allHard: false,
+		},
+		{
+			name:    "Long",
+			path:    "//Each derived keypair is coupled with a path which prevents it being used in another network",
+			allHard: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dp, err := ParseDerivationPath(tt.path)
+
+			if err != nil {
+				t.Fatalf("Error parsing derivation path: %v", err)
+			}
+
+			if dp.String() != tt.path {
+				t.Errorf("Invalid path, expected %v, got %v", tt.path, dp.String())
+			}
+
+			if dp.AllHard() != tt.allHard {
+				t.Errorf("Invalid all hard flag, expected %v, got %v", tt.allHard, dp.AllHard())
+			}
+
+			// each junction formats to its path part and parses to the same
+			// junction
+			parts := (&SecretURI{Path: tt.path}).pathParts()
+
+			if len(parts) != len(dp) {
+				t.Fatalf("Invalid junction count, expected %d, got %d", len(parts), len(dp))
+			}
+
+			for i, part := range parts {
+				j := dp[i]
+
+				if j.String() != "/"+part {
+					t.Errorf("Invalid junction, expected /%v, got %v", part, j.String())
+				}
+
+				if j.Hard() != strings.HasPrefix(part, "/") {
+					t.Errorf("Invalid hard flag for %v", part)
+				}
+
+				pj, err := ParseJunction(j.String())
+
+				if err != nil {
+					t.Fatalf("Error parsing junction: %v", err)
+				}
+
+				if !reflect.DeepEqual(pj, j) {
+					t.Errorf("Invalid parsed junction, expected %v, got %v", j, pj)
+				}
+			}
+		})
+	}
+}
+
+func TestNewJunctionTypes(t *testing.T) {
+
+	str, err := NewStringJunction("polkadot", true)
+
+	if err != nil {
+		t.Fatalf("Error creating junction: %v", err)
+	}
+
+	num, err := NewStringJunction("0", false)
+
+	if err != nil {
+		t.Fatalf("Error creating junction: %v", err)
+	}
+
+	cc := [32]byte{1, 2, 3}
+
+	tests := []struct {
+		name      string
+		junction  *Junction
+		str       string
+		hard      bool
+		chainCode [32]byte
+	}{
+		{
+			name:      "Index Hard",
+			junction:  NewIndexJunction(1, true),
+			str:       "//1",
+			hard:      true,
+			chainCode: [32]byte{1},
+		},
+		{
+			name:      "Index Soft",
+			junction:  NewIndexJunction(0x0102, false),
+			str:       "/258",
+			hard:      false,
+			chainCode: [32]byte{2, 1},
+		},
+		{
+			name:      "String Hard",
+			junction:  str,
+			str:       "//polkadot",
+			hard:      true,
+			chainCode: [32]byte{32, 'p', 'o', 'l', 'k', 'a', 'd', 'o', 't'},
+		},
+		{
+			name:      "Numeric String",
+			junction:  num,
+			str:       "/0",
+			hard:      false,
+			chainCode: [32]byte{4, '0'},
+		},
+		{
+			name:      "Chain Code",
+			junction:  NewChainCodeJunction(cc, true),
+			str:       "//0x0102030000000000000000000000000000000000000000000000000000000000",
+			hard:      true,
+			chainCode: cc,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if tt.junction.String() != tt.str {
+				t.Errorf("Invalid string, expected %v, got %v", tt.str, tt.junction.String())
+			}
+
+			if tt.junction.Hard() != tt.hard {
+				t.Errorf("Invalid hard flag, expected %v, got %v", tt.hard, tt.junction.Hard())
+			}
+
+			if tt.junction.ChainCode() != tt.chainCode {
+				t.Errorf("Invalid chain code, expected %v, got %v", tt.chainCode, tt.junction.ChainCode())
+			}
+		})
+	}
+
+	// index and string junctions match parsed junctions
+	for _, j := range []*Junction{NewIndexJunction(42, true), str} {
+		pj, err := ParseJunction(j.String())
+
+		if err != nil {
+			t.Fatalf("Error parsing junction: %v", err)
+		}
+
+		if !reflect.DeepEqual(pj, j) {
+			t.Errorf("Invalid parsed junction, expected %v, got %v", j, pj)
+		}
+	}
+}
+
+func TestJunctionInvalid(t *testing.T) {
+
+	for _, str := range []string{"", "joe", "///joe", "//joe/0", "/"} {
+		if _, err := ParseJunction(str); err != ErrInvalidJunction {
+			t.Errorf("Invalid error for %q, expected %v, got %v", str, ErrInvalidJunction, err)
+		}
+	}
+
+	for _, str := range []string{"", "joe/0"} {
+		if _, err := NewStringJunction(str, true); err != ErrInvalidJunction {
+			t.Errorf("Invalid error for %q, expected %v, got %v", str, ErrInvalidJunction, err)
+		}
+	}
+
+	if _, err := ParseDerivationPath("joe"); err != ErrInvalidDerivePath {
+		t.Errorf("Expected invalid derive path error, got %v", err)
+	}
+}
+
+func TestUnicodeJunction(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		part      string
+		chainCode []byte
+	}{
+		{
+			name: "Katakana",
+			part: "ウォレット",
+			// compact length of 15 UTF-8 bytes followed by the bytes
+			chainCode: append([]byte{15 << 2}, "ウォレット"...),
+		},
+		{
+			name: "Accented",
+			part: "ñandú",
+			// 5 characters of 7 UTF-8 bytes
+			chainCode: append([]byte{7 << 2}, "ñandú"...),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			j, err := ParseJunction("//" + tt.part)
+
+			if err != nil {
+				t.Fatalf("Error parsing junction: %v", err)
+			}
+
+			var expected [32]byte
+			copy(expected[:], tt.chainCode)
+
+			if j.ChainCode() != expected {
+				t.Errorf("Invalid chain code, expected %x, got %x", expected, j.ChainCode())
+			}
+
+			// key derived through the Secret URI matches direct derivation
+			kr, err := FromURI(devPhrase+"//"+tt.part, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error creating keyring: %v", err)
+			}
+
+			root, err := FromURI(devPhrase, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error creating keyring: %v", err)
+			}
+
+			child, err := root.Derive(j.String())
+
+			if err != nil {
+				t.Fatalf("Error deriving keyring: %v", err)
+			}
+
+			if kr.Public() != child.Public() {
+				t.Errorf("Invalid public key, expected %x, got %x", kr.Public(), child.Public())
+			}
+		})
+	}
+}
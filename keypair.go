@@ -0,0 +1,257 @@
+package srkeyring
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// derivePathRe is the regular expression for matching a derivation path
+	// consisting only of hard and soft junctions
+	derivePathRe = regexp.MustCompile(`^(//?[^/]+)*$`)
+
+	ErrUnknownScheme     = errors.New("Unknown key pair scheme")
+	ErrInvalidDerivePath = errors.New("Derivation path format is invalid")
+)
+
+// Scheme specifies the cryptographic scheme used by a KeyPair
+type Scheme int
+
+const (
+	Sr25519 Scheme = iota + 1
+	Ed25519
+	Ecdsa
+)
+
+// String returns the scheme name as used by subkey
+func (s Scheme) String() string {
+	switch s {
+	case Sr25519:
+		return "sr25519"
+	case Ed25519:
+		return "ed25519"
+	case Ecdsa:
+		return "ecdsa"
+	default:
+		return fmt.Sprintf("Scheme(%d)", int(s))
+	}
+}
+
+// ParseScheme returns the Scheme for the given scheme name
+func ParseScheme(name string) (Scheme, error) {
+	switch strings.ToLower(name) {
+	case "sr25519":
+		return Sr25519, nil
+	case "ed25519":
+		return Ed25519, nil
+	case "ecdsa":
+		return Ecdsa, nil
+	default:
+		return 0, ErrUnknownScheme
+	}
+}
+
+// KeyPair defines the interface common to key pairs of all schemes so they
+// can be handled uniformly
+type KeyPair interface {
+	// Scheme returns the cryptographic scheme of the key pair
+	Scheme() Scheme
+	// PublicKey returns the public key in raw bytes
+	PublicKey() []byte
+	// AccountID returns the account id the SS58 address is formed from
+	AccountID() [32]byte
+	// SS58Address returns the account id encoded as a SS58 address
+	SS58Address() (string, error)
+	// SignMessage signs the message and returns the signature in raw bytes
+	SignMessage(msg []byte) ([]byte, error)
+	// VerifyMessage verifies the message against the signature
+	VerifyMessage(msg []byte, signature []byte) bool
+	// DeriveKeyPair returns a child key pair derived from the given path of
+	// hard and soft junctions, eg: "//polkadot/0"
+	DeriveKeyPair(path string) (KeyPair, error)
+}
+
+// force key rings to implement KeyPair interface
+var (
+	_ KeyPair = &KeyRing{}
+	_ KeyPair = &Ed25519KeyRing{}
+	_ KeyPair = &EcdsaKeyRing{}
+)
+
+// FromURIWithScheme returns a KeyPair of the given scheme from the Secret URI
+func FromURIWithScheme(str string, net Network, scheme Scheme) (KeyPair, error) {
+	switch scheme {
+	case Sr25519:
+		return FromURI(str, net)
+	case Ed25519:
+		return Ed25519FromURI(str, net)
+	case Ecdsa:
+		return EcdsaFromURI(str, net)
+	default:
+		return nil, ErrUnknownScheme
+	}
+}
+
+// deriveURI returns the Secret URI for a child key of the given Secret URI
+// by appending the derivation path.  phrase replaces the Secret URI phrase
+// when the key was not created from a phrase, such as from a raw public key
+func deriveURI(suri *SecretURI, phrase, path string) (string, error) {
+
+	if !derivePathRe.MatchString(path) {
+		return "", ErrInvalidDerivePath
+	}
+
+	if suri.Phrase != "" {
+		phrase = suri.Phrase
+	}
+
+	str := phrase + suri.Path + path
+
+	if suri.Password != "" {
+		str += "///" + suri.Password
+	}
+
+	return str, nil
+}
+
+// Scheme returns the cryptographic scheme of the key pair
+func (k *KeyRing) Scheme() Scheme {
+	return Sr25519
+}
+
+// PublicKey returns the public key in raw bytes
+func (k *KeyRing) PublicKey() []byte {
+	pub := k.Public()
+	return pub[:]
+}
+
+// AccountID returns the account id which is the public key
+func (k *KeyRing) AccountID() [32]byte {
+	return k.Public()
+}
+
+// SignMessage signs the message using the KeyRing's signing context
+func (k *KeyRing) SignMessage(msg []byte) ([]byte, error) {
+	sig, err := k.Sign(k.SigningContext(msg))
+	return sig[:], err
+}
+
+// VerifyMessage verifies the message against the signature using the
+// KeyRing's signing context
+func (k *KeyRing) VerifyMessage(msg []byte, signature []byte) bool {
+	var sig [64]byte
+
+	if len(signature) != len(sig) {
+		return false
+	}
+
+	copy(sig[:], signature)
+
+	return k.Verify(k.SigningContext(msg), sig)
+}
+
+// DeriveKeyPair returns a child KeyRing derived from the given path
+func (k *KeyRing) DeriveKeyPair(path string) (KeyPair, error) {
+	return k.Derive(path)
+}
+
+// Scheme returns the cryptographic scheme of the key pair
+func (k *Ed25519KeyRing) Scheme() Scheme {
+	return Ed25519
+}
+
+// PublicKey returns the public key in raw bytes
+func (k *Ed25519KeyRing) PublicKey() []byte {
+	pub := k.Public()
+	return pub[:]
+}
+
+// AccountID returns the account id which is the public key
+func (k *Ed25519KeyRing) AccountID() [32]byte {
+	return k.Public()
+}
+
+// SignMessage signs the message using the secret key
+func (k *Ed25519KeyRing) SignMessage(msg []byte) ([]byte, error) {
+	sig, err := k.Sign(msg)
+	return sig[:], err
+}
+
+// VerifyMessage verifies the message against the signature
+func (k *Ed25519KeyRing) VerifyMessage(msg []byte, signature []byte) bool {
+	var sig [64]byte
+
+	if len(signature) != len(sig) {
+		return false
+	}
+
+	copy(sig[:], signature)
+
+	return k.Verify(msg, sig)
+}
+
+// DeriveKeyPair returns a child Ed25519KeyRing derived from the given path
+func (k *Ed25519KeyRing) DeriveKeyPair(path string) (KeyPair, error) {
+
+	ss58, err := k.SS58Address()
+
+	if err != nil {
+		return nil, err
+	}
+
+	str, err := deriveURI(k.suri, ss58, path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Ed25519FromURI(str, k.suri.Network)
+}
+
+// Scheme returns the cryptographic scheme of the key pair
+func (k *EcdsaKeyRing) Scheme() Scheme {
+	return Ecdsa
+}
+
+// PublicKey returns the compressed public key in raw bytes
+func (k *EcdsaKeyRing) PublicKey() []byte {
+	pub := k.Public()
+	return pub[:]
+}
+
+// SignMessage signs the message using the secret key
+func (k *EcdsaKeyRing) SignMessage(msg []byte) ([]byte, error) {
+	sig, err := k.Sign(msg)
+	return sig[:], err
+}
+
+// VerifyMessage verifies the message against the signature
+func (k *EcdsaKeyRing) VerifyMessage(msg []byte, signature []byte) bool {
+	var sig [EcdsaSignatureLength]byte
+
+	if len(signature) != len(sig) {
+		return false
+	}
+
+	copy(sig[:], signature)
+
+	return k.Verify(msg, sig)
+}
+
+// DeriveKeyPair returns a child EcdsaKeyRing derived from the given path
+func (k *EcdsaKeyRing) DeriveKeyPair(path string) (KeyPair, error) {
+
+	if !k.hasSecret {
+		return nil, ErrPublicDerivation
+	}
+
+	str, err := deriveURI(k.suri, "", path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return EcdsaFromURI(str, k.suri.Network)
+}
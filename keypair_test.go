@@ -0,0 +1,276 @@
+package srkeyring
+
+import (
+	"testing"
+)
+
+const devPhrase = "bottom drive obey lake curtain smoke basket hold race lonely fit walk"
+
+var keyPairTests = []struct {
+	name   string
+	scheme Scheme
+	suri   string
+	public string
+	ss58   string
+}{
+	{
+		name:   "Sr25519 Alice",
+		scheme: Sr25519,
+		suri:   devPhrase + "//Alice",
+		public: "0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+		ss58:   "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+	},
+	{
+		name:   "Ed25519 Alice",
+		scheme: Ed25519,
+		suri:   devPhrase + "//Alice",
+		public: "0x88dc3417d5058ec4b4503e0c12ea1a0a89be200fe98922423d4334014fa6b0ee",
+		ss58:   "5FA9nQDVg267DEd8m1ZypXLBnvN7SFxYwV7ndqSYGiN9TTpu",
+	},
+	{
+		name:   "Ecdsa Alice",
+		scheme: Ecdsa,
+		suri:   devPhrase + "//Alice",
+		public: "0x020a1091341fe5664bfa1782d5e04779689068c916b04cb365ec3153755684d9a1",
+		ss58:   "5C7C2Z5sWbytvHpuLTvzKunnnRwQxft1jiqrLD5rhucQ5S9X",
+	},
+}
+
+func TestFromURIWithScheme(t *testing.T) {
+
+	for _, tt := range keyPairTests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kp, err := FromURIWithScheme(tt.suri, NetSubstrate{}, tt.scheme)
+
+			if err != nil {
+				t.Fatalf("Error generating key pair: %v", err)
+			}
+
+			if kp.Scheme() != tt.scheme {
+				t.Errorf("Wrong scheme, expected %v, got %v", tt.scheme, kp.Scheme())
+			}
+
+			pub := EncodeHex(kp.PublicKey(), "0x")
+
+			if pub != tt.public {
+				t.Errorf("Invalid public key, expected %v, got %v", tt.public, pub)
+			}
+
+			ss58, err := kp.SS58Address()
+
+			if err != nil {
+				t.Fatalf("Error getting SS58 Address: %v", err)
+			}
+
+			if ss58 != tt.ss58 {
+				t.Errorf("Invalid SS58 Address, expected %v, got %v", tt.ss58, ss58)
+			}
+
+			acc, err := DecodeSS58Address(ss58, NetSubstrate{}, SS58Checksum)
+
+			if err != nil {
+				t.Fatalf("Error decoding SS58 Address: %v", err)
+			}
+
+			if acc != kp.AccountID() {
+				t.Errorf("Invalid account id, expected %x, got %x", acc, kp.AccountID())
+			}
+
+			msg := []byte("key pair message")
+			sig, err := kp.SignMessage(msg)
+
+			if err != nil {
+				t.Fatalf("Error signing message: %v", err)
+			}
+
+			if !kp.VerifyMessage(msg, sig) {
+				t.Errorf("Error signature does not verify")
+			}
+
+			if kp.VerifyMessage([]byte("other message"), sig) {
+				t.Errorf("Error signature verified for wrong message")
+			}
+
+			if kp.VerifyMessage(msg, sig[:10]) {
+				t.Errorf("Error short signature verified")
+			}
+		})
+	}
+}
+
+func TestDeriveKeyPair(t *testing.T) {
+
+	for _, tt := range keyPairTests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root, err := FromURIWithScheme(devPhrase, NetSubstrate{}, tt.scheme)
+
+			if err != nil {
+				t.Fatalf("Error generating key pair: %v", err)
+			}
+
+			kp, err := root.DeriveKeyPair("//Alice")
+
+			if err != nil {
+				t.Fatalf("Error deriving key pair: %v", err)
+			}
+
+			ss58, err := kp.SS58Address()
+
+			if err != nil {
+				t.Fatalf("Error getting SS58 Address: %v", err)
+			}
+
+			if ss58 != tt.ss58 {
+				t.Errorf("Invalid SS58 Address, expected %v, got %v", tt.ss58, ss58)
+			}
+
+			if _, err := root.DeriveKeyPair("Alice"); err != ErrInvalidDerivePath {
+				t.Errorf("Expected invalid derive path error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDeriveKeyPairPublic(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		scheme Scheme
+		suri   string
+		path   string
+		ss58   string
+		valid  bool
+	}{
+		{
+			name:   "Sr25519 Soft",
+			scheme: Sr25519,
+			suri:   "zebra extra skill occur rose muscle reveal robust cigar tilt jungle coral",
+			path:   "/john/account/1",
+			ss58:   "5Ei1zCfgteYZ1xv3g9nkRmAFPCSegbKRfAX1XXCMZTg2fDHJ",
+			valid:  true,
+		},
+		{
+			name:   "Ed25519 Hard",
+			scheme: Ed25519,
+			suri:   "5FA9nQDVg267DEd8m1ZypXLBnvN7SFxYwV7ndqSYGiN9TTpu",
+			path:   "//john",
+			valid:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root, err := FromURIWithScheme(tt.suri, NetSubstrate{}, tt.scheme)
+
+			if err != nil {
+				t.Fatalf("Error generating key pair: %v", err)
+			}
+
+			ss58, err := root.SS58Address()
+
+			if err != nil {
+				t.Fatalf("Error getting SS58 Address: %v", err)
+			}
+
+			// create public only key pair from the SS58 Address
+			pub, err := FromURIWithScheme(ss58, NetSubstrate{}, tt.scheme)
+
+			if err != nil {
+				t.Fatalf("Error generating public key pair: %v", err)
+			}
+
+			kp, err := pub.DeriveKeyPair(tt.path)
+
+			if !tt.valid {
+				if err == nil {
+					t.Errorf("Expected error deriving from public key")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Error deriving key pair: %v", err)
+			}
+
+			res, err := kp.SS58Address()
+
+			if err != nil {
+				t.Fatalf("Error getting SS58 Address: %v", err)
+			}
+
+			if res != tt.ss58 {
+				t.Errorf("Invalid SS58 Address, expected %v, got %v", tt.ss58, res)
+			}
+
+			if _, err := kp.SignMessage([]byte("msg")); err != ErrNoSecretKey {
+				t.Errorf("Expected no secret key error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestParseScheme(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		scheme Scheme
+		valid  bool
+	}{
+		{
+			name:   "sr25519",
+			scheme: Sr25519,
+			valid:  true,
+		},
+		{
+			name:   "Ed25519",
+			scheme: Ed25519,
+			valid:  true,
+		},
+		{
+			name:   "ecdsa",
+			scheme: Ecdsa,
+			valid:  true,
+		},
+		{
+			name:  "rsa",
+			valid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := ParseScheme(tt.name)
+
+			if !tt.valid {
+				if err != ErrUnknownScheme {
+					t.Errorf("Expected unknown scheme error, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Error parsing scheme: %v", err)
+			}
+
+			if res != tt.scheme {
+				t.Errorf("Wrong scheme, expected %v, got %v", tt.scheme, res)
+			}
+
+			if res.String() != tt.scheme.String() {
+				t.Errorf("Wrong scheme name, expected %v, got %v", tt.scheme, res)
+			}
+		})
+	}
+}
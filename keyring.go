@@ -1,6 +1,7 @@
 // Package srkeyring provides functions to implementation HDKD
 // (Hierarchical Deterministic Key Derivation) using sr25519
-// (Schnorr over Ristretto25519).
+// (Schnorr over Ristretto25519), with ed25519 and ecdsa key pairs also
+// supported.
 //
 // Compatible with Substrates key generation and command line utility subkey.
 //
@@ -10,10 +11,11 @@
 package srkeyring
 
 import (
+	"crypto/rand"
 	"errors"
 	sr25519 "github.com/ChainSafe/go-schnorrkel"
-	"github.com/cosmos/go-bip39"
 	"github.com/gtank/merlin"
+	"io"
 )
 
 var (
@@ -22,8 +24,13 @@ var (
 	ErrSeedNotAvailable  = errors.New("Unable to get seed from public address data")
 	ErrInvalidWordCount  = errors.New("An invalid WordCount was given, valid values are 12, 15, 18, 21, or 24")
 	ErrNonMnemonic       = errors.New("Error KeyRing was generated from non mnemonic source")
+	ErrNoNetwork         = errors.New("A Network must be given")
 )
 
+// DefaultSigningContext is the sr25519 signing context used by Substrate nodes
+// and polkadot-js when signing and verifying messages
+const DefaultSigningContext = "substrate"
+
 // KeyRing defines a key pair from a derive Secret URI
 type KeyRing struct {
 	// secret is the private key
@@ -41,6 +48,14 @@ type KeyRing struct {
 	seed [32]byte
 	// hasSeed is a flag to indicate if a seed is set
 	hasSeed bool
+	// sigContext is the signing context used for the message transcript, when
+	// not set DefaultSigningContext is used
+	sigContext []byte
+	// nonce is the signing nonce of the secret key when known, such as when
+	// imported from an encrypted JSON account
+	nonce [32]byte
+	// hasNonce is a flag to indicate if the nonce is set
+	hasNonce bool
 }
 
 // WordCount defines the type for specifying the number of words in a mnemonic.
@@ -60,31 +75,121 @@ var entropyWords = map[WordCount]entropyBits{
 	24: 256,
 }
 
-// Generate creates a new KeyRing with a randomly created mnemonic of the
-// specified number of words
+// Generate creates a new KeyRing with a randomly created English mnemonic of
+// the specified number of words
 func Generate(words int, net Network) (*KeyRing, error) {
+	return GenerateWithLanguage(words, English, net)
+}
+
+// GenerateWithLanguage creates a new KeyRing with a randomly created mnemonic
+// of the specified number of words using the wordlist of the language
+func GenerateWithLanguage(words int, lang Language, net Network) (*KeyRing, error) {
+
+	// check the arguments as GenerateWithOptions uses defaults for unset
+	// options
+	if _, ok := entropyWords[WordCount(words)]; !ok {
+		return nil, ErrInvalidWordCount
+	}
+
+	if _, err := getWordlist(lang); err != nil {
+		return nil, err
+	}
+
+	if net == nil {
+		return nil, ErrNoNetwork
+	}
+
+	return GenerateWithOptions(GenerateOptions{
+		Words:    words,
+		Language: lang,
+		Network:  net,
+	})
+}
+
+// GenerateOptions defines how a new KeyRing mnemonic is generated
+type GenerateOptions struct {
+	// Words is the number of mnemonic words, defaults to 12
+	Words int
+	// Language is the mnemonic wordlist language, defaults to English
+	Language Language
+	// Network is the network of the KeyRing, defaults to NetSubstrate
+	Network Network
+	// Rand is the source of randomness the mnemonic entropy is read from,
+	// defaults to crypto/rand.Reader.  A deterministic reader must only be
+	// used for test fixtures
+	Rand io.Reader
+}
+
+// withDefaults returns the options with unset values set to their defaults
+func (o GenerateOptions) withDefaults() GenerateOptions {
+
+	if o.Words == 0 {
+		o.Words = 12
+	}
+
+	if o.Language == 0 {
+		o.Language = English
+	}
+
+	if o.Network == nil {
+		o.Network = NetSubstrate{}
+	}
+
+	if o.Rand == nil {
+		o.Rand = rand.Reader
+	}
+
+	return o
+}
+
+// GenerateWithOptions creates a new KeyRing with a mnemonic created from
+// entropy read from the options randomness source
+func GenerateWithOptions(opts GenerateOptions) (*KeyRing, error) {
+
+	opts = opts.withDefaults()
 
 	// check word count is valid
-	bitsize, ok := entropyWords[WordCount(words)]
+	bitsize, ok := entropyWords[WordCount(opts.Words)]
 
 	if !ok {
 		return nil, ErrInvalidWordCount
 	}
 
 	// create entropy and mnemonic
-	entropy, err := bip39.NewEntropy(int(bitsize))
+	entropy := make([]byte, bitsize/8)
+
+	if _, err := io.ReadFull(opts.Rand, entropy); err != nil {
+		return nil, err
+	}
+
+	mnemonic, err := entropyToMnemonic(entropy, opts.Language)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return FromURI(mnemonic, opts.Network)
+}
+
+// FromEntropy returns a KeyRing from the raw BIP39 entropy of 16, 20, 24, 28,
+// or 32 bytes and the password.  As Substrate creates the key from the
+// entropy rather than the mnemonic words, the KeyRing's mnemonic is the
+// English mnemonic of the entropy
+func FromEntropy(entropy []byte, password string, net Network) (*KeyRing, error) {
+
+	mnemonic, err := entropyToMnemonic(entropy, English)
 
 	if err != nil {
 		return nil, err
 	}
 
-	mnemonic, err := bip39.NewMnemonic(entropy)
+	suri, err := NewSecretURIBuilder(mnemonic, net).Password(password).Build()
 
 	if err != nil {
 		return nil, err
 	}
 
-	return FromURI(mnemonic, net)
+	return FromURI(suri.String(), net)
 }
 
 // FromPublic returns a KeyRing from the raw bytes of a public key
@@ -126,8 +231,123 @@ func FromURI(str string, net Network) (*KeyRing, error) {
 		return nil, err
 	}
 
+	dvKey, msKey, allPathsHard, err := deriveJunctions(dvKey, dvPrivate, junctions)
+
+	if err != nil {
+		return nil, err
+	}
+
+	kr := &KeyRing{
+		hasSecret: bool(dvPrivate),
+		suri:      suri,
+	}
+
+	// if the suri provided secret input, or if the suri provided a public
+	// key/address or secret and included a path (with junctions) to derive from
+	if dvPrivate {
+		// private key secret was provided
+		kr.secret = dvKey.(*sr25519.SecretKey)
+		kr.pub, err = dvKey.(*sr25519.SecretKey).Public()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if allPathsHard && len(junctions) > 0 {
+			kr.seed = msKey.Encode()
+			kr.hasSeed = true
+		}
+
+	} else {
+		// public key was provided
+		kr.pub = dvKey.(*sr25519.PublicKey)
+	}
+
+	return kr, nil
+}
+
+// Derive returns a child KeyRing derived from the given path of hard and
+// soft junctions, eg: "//polkadot/0".  The child is derived directly from the
+// KeyRing's secret key, or public key for soft junctions when no secret is
+// available, so the mnemonic phrase is not stretched again.  The result is
+// the same as calling FromURI with the path appended to the Secret URI
+func (k *KeyRing) Derive(path string) (*KeyRing, error) {
+
+	junctions, err := ParseDerivationPath(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the seed is only tracked when the parent and child paths are all hard
+	parentJunctions, err := k.suri.GetJunctions()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var dvKey sr25519.DerivableKey = k.pub
+
+	if k.hasSecret {
+		dvKey = k.secret
+	}
+
+	dvKey, msKey, allPathsHard, err := deriveJunctions(dvKey, DerivablePrivateKey(k.hasSecret), junctions)
+
+	if err != nil {
+		return nil, err
+	}
+
+	suri := *k.suri
+	suri.Path += path
+
+	kr := &KeyRing{
+		hasSecret:  k.hasSecret,
+		suri:       &suri,
+		sigContext: k.sigContext,
+	}
+
+	if len(junctions) == 0 {
+		// no derivation so the parent's keys and seed are kept
+		kr.secret = k.secret
+		kr.pub = k.pub
+		kr.seed = k.seed
+		kr.hasSeed = k.hasSeed
+		kr.nonce = k.nonce
+		kr.hasNonce = k.hasNonce
+
+		return kr, nil
+	}
+
+	if k.hasSecret {
+		kr.secret = dvKey.(*sr25519.SecretKey)
+		kr.pub, err = kr.secret.Public()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if DerivationPath(parentJunctions).AllHard() && allPathsHard {
+			kr.seed = msKey.Encode()
+			kr.hasSeed = true
+		}
+
+	} else {
+		kr.pub = dvKey.(*sr25519.PublicKey)
+	}
+
+	return kr, nil
+}
+
+// deriveJunctions derives the key through each of the junctions in turn and
+// returns the derived key, the MiniSecretKey of the last hard junction when
+// deriving from a secret key, and a flag indicating if all junctions were hard
+func deriveJunctions(dvKey sr25519.DerivableKey, dvPrivate DerivablePrivateKey,
+	junctions []*Junction) (sr25519.DerivableKey, *sr25519.MiniSecretKey, bool, error) {
+
 	var exKey *sr25519.ExtendedKey
 	var msKey *sr25519.MiniSecretKey
+	var err error
 	// allPathsHard is a flag to indicate if all path junctions are Hard. If
 	// they are, then the secret Seed can be derived from the MiniSecretKey
 	allPathsHard := true
@@ -151,7 +371,7 @@ func FromURI(str string, net Network) (*KeyRing, error) {
 		}
 
 		if err != nil {
-			return nil, err
+			return nil, nil, false, err
 		}
 
 		if dvPrivate {
@@ -161,37 +381,11 @@ func FromURI(str string, net Network) (*KeyRing, error) {
 		}
 
 		if err != nil {
-			return nil, err
-		}
-	}
-
-	kr := &KeyRing{
-		hasSecret: bool(dvPrivate),
-		suri:      suri,
-	}
-
-	// if the suri provided secret input, or if the suri provided a public
-	// key/address or secret and included a path (with junctions) to derive from
-	if dvPrivate {
-		// private key secret was provided
-		kr.secret = dvKey.(*sr25519.SecretKey)
-		kr.pub, err = dvKey.(*sr25519.SecretKey).Public()
-
-		if err != nil {
-			return nil, err
-		}
-
-		if allPathsHard && len(junctions) > 0 {
-			kr.seed = msKey.Encode()
-			kr.hasSeed = true
+			return nil, nil, false, err
 		}
-
-	} else {
-		// public key was provided
-		kr.pub = dvKey.(*sr25519.PublicKey)
 	}
 
-	return kr, nil
+	return dvKey, msKey, allPathsHard, nil
 }
 
 // deriveHardMiniKey implements similar functionality to sr25519.DeriveKeyHard()
@@ -213,6 +407,11 @@ func deriveHardMiniKey(key sr25519.DerivableKey, i []byte, cc [32]byte) (
 
 // Sign signs the message using the secret key
 func (k *KeyRing) Sign(t *merlin.Transcript) (signature [64]byte, err error) {
+
+	if !k.hasSecret {
+		return signature, ErrNoSecretKey
+	}
+
 	sig, err := k.secret.Sign(t)
 
 	if err != nil {
@@ -239,10 +438,36 @@ func (k *KeyRing) Verify(t *merlin.Transcript, signature [64]byte) bool {
 	return res
 }
 
-// SigningContext returns the transcript used for message signing for the
-// Network set
+// SigningContext returns the transcript used for message signing using the
+// KeyRing's signing context
 func (k *KeyRing) SigningContext(msg []byte) *merlin.Transcript {
-	return sr25519.NewSigningContext([]byte(k.suri.Network.Name()), msg)
+	return sr25519.NewSigningContext(k.Context(), msg)
+}
+
+// Context returns the signing context used for message signing, which
+// defaults to DefaultSigningContext and is independent of the Network
+func (k *KeyRing) Context() []byte {
+	if k.sigContext == nil {
+		return []byte(DefaultSigningContext)
+	}
+
+	return append([]byte{}, k.sigContext...)
+}
+
+// SetSigningContext sets the signing context used for message signing
+func (k *KeyRing) SetSigningContext(ctx []byte) {
+	k.sigContext = append([]byte{}, ctx...)
+}
+
+// SignWithContext signs the message using the given signing context
+func (k *KeyRing) SignWithContext(ctx, msg []byte) ([64]byte, error) {
+	return k.Sign(sr25519.NewSigningContext(ctx, msg))
+}
+
+// VerifyWithContext verifies the message against the signature using the
+// given signing context
+func (k *KeyRing) VerifyWithContext(ctx, msg []byte, signature [64]byte) bool {
+	return k.Verify(sr25519.NewSigningContext(ctx, msg), signature)
 }
 
 // Public returns the public key in raw bytes
@@ -266,6 +491,24 @@ func (k *KeyRing) Mnemonic() (string, error) {
 	return "", ErrNonMnemonic
 }
 
+// Entropy returns the BIP39 entropy of the mnemonic phrase if the KeyRing was
+// generated by a mnemonic phrase or an error if generated by other source.
+// The entropy is that of the root mnemonic, the derivation path and password
+// of the Secret URI are not applied
+func (k *KeyRing) Entropy() ([]byte, error) {
+	if k.suri.Type != Mnemonic {
+		return nil, ErrNonMnemonic
+	}
+
+	lang, err := DetectLanguage(k.suri.Phrase)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mnemonicToEntropy(k.suri.Phrase, lang)
+}
+
 // Secret returns the private secret key in raw bytes
 func (k *KeyRing) Secret() [32]byte {
 	return k.secret.Encode()
@@ -313,6 +556,14 @@ func (k *KeyRing) Seed() ([32]byte, error) {
 
 		return res, nil
 
+	case RawSecretKey:
+		if k.hasSeed {
+			// seed was derived from the secret key due to Hard paths
+			return k.seed, nil
+		}
+
+		return res, ErrSeedNotAvailable
+
 	case SS58Public, RawPublicKey:
 		return res, ErrSeedNotAvailable
 
@@ -323,7 +574,7 @@ func (k *KeyRing) Seed() ([32]byte, error) {
 		}
 
 		// calculate seed from suri Phrase when no Path is set
-		seed, err := sr25519.SeedFromMnemonic(k.suri.Phrase, k.suri.Password)
+		seed, err := mnemonicSeed(k.suri.Phrase, k.suri.Password)
 
 		if err != nil {
 			return res, err
@@ -1,8 +1,11 @@
 package srkeyring
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
+	"io"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -417,6 +420,105 @@ func TestVerify(t *testing.T) {
 	}
 }
 
+func TestSigningContextNetwork(t *testing.T) {
+
+	for _, tt := range msgTests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			subKr, err := FromURI(tt.ss58, tt.net)
+
+			if err != nil {
+				t.Fatalf("Error generating key ring: %v", err)
+			}
+
+			// signatures created by subkey use the "substrate" signing context
+			// so must verify regardless of the network used for addressing
+			kr, err := FromPublic(subKr.Public(), netWide{prefix: 0})
+
+			if err != nil {
+				t.Fatalf("Error generating key ring: %v", err)
+			}
+
+			sig, err := hex.DecodeString(tt.sig)
+
+			if err != nil {
+				t.Fatalf("Invalid hex decode: %v", err)
+			}
+
+			var sigB [64]byte
+			copy(sigB[:], sig)
+
+			if !kr.Verify(kr.SigningContext(tt.msg), sigB) {
+				t.Errorf("Error signature does not verify")
+			}
+
+			if !kr.VerifyWithContext([]byte(DefaultSigningContext), tt.msg, sigB) {
+				t.Errorf("Error signature does not verify with default context")
+			}
+
+			if kr.VerifyWithContext([]byte("polkadot"), tt.msg, sigB) {
+				t.Errorf("Error signature verified with wrong context")
+			}
+		})
+	}
+}
+
+func TestSignWithContext(t *testing.T) {
+
+	tests := []struct {
+		name string
+		ctx  []byte
+	}{
+		{
+			name: "Default Context",
+			ctx:  []byte(DefaultSigningContext),
+		},
+		{
+			name: "Custom Context",
+			ctx:  []byte("my custom context"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kr, err := FromURI(msgTests[0].suri, msgTests[0].net)
+
+			if err != nil {
+				t.Fatalf("Error generating key ring: %v", err)
+			}
+
+			msg := []byte("context message")
+
+			sig, err := kr.SignWithContext(tt.ctx, msg)
+
+			if err != nil {
+				t.Fatalf("Error signing message: %v", err)
+			}
+
+			if !kr.VerifyWithContext(tt.ctx, msg, sig) {
+				t.Errorf("Error signature does not verify with context")
+			}
+
+			// setting the keyring signing context should make the default
+			// transcript match the given context
+			kr.SetSigningContext(tt.ctx)
+
+			if string(kr.Context()) != string(tt.ctx) {
+				t.Errorf("Wrong signing context, expected %s, got %s", tt.ctx, kr.Context())
+			}
+
+			if !kr.Verify(kr.SigningContext(msg), sig) {
+				t.Errorf("Error signature does not verify with keyring context")
+			}
+		})
+	}
+}
+
 // TestKeyRingSharing runs an integration test consisting of two parties, (i) the
 // keyring "Owner", and (ii) a "Website" with HD key to receive payments from.
 func TestKeyRingSharing(t *testing.T) {
@@ -940,6 +1042,7 @@ func TestGenerate(t *testing.T) {
 		wcnt  int
 		net   Network
 		valid bool
+		err   error
 	}{
 		{
 			name:  "Word count 12",
@@ -976,6 +1079,21 @@ func TestGenerate(t *testing.T) {
 			wcnt:  13,
 			net:   NetSubstrate{},
 			valid: false,
+			err:   ErrInvalidWordCount,
+		},
+		{
+			name:  "Word count zero",
+			wcnt:  0,
+			net:   NetSubstrate{},
+			valid: false,
+			err:   ErrInvalidWordCount,
+		},
+		{
+			name:  "Nil network",
+			wcnt:  12,
+			net:   nil,
+			valid: false,
+			err:   ErrNoNetwork,
 		},
 	}
 
@@ -989,6 +1107,10 @@ func TestGenerate(t *testing.T) {
 			if err != nil {
 				if !tt.valid {
 					// error expected
+					if err != tt.err {
+						t.Errorf("Invalid error, expected %v, got %v", tt.err, err)
+					}
+
 					return
 				}
 
@@ -1011,6 +1133,253 @@ func TestGenerate(t *testing.T) {
 	}
 }
 
+func TestGenerateWithOptions(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		opts     GenerateOptions
+		mnemonic string
+		err      error
+	}{
+		{
+			name: "Defaults",
+			opts: GenerateOptions{
+				Rand: bytes.NewReader(make([]byte, 16)),
+			},
+			mnemonic: strings.Repeat("abandon ", 11) + "about",
+		},
+		{
+			name: "24 Words",
+			opts: GenerateOptions{
+				Words:   24,
+				Network: netWide{prefix: 0},
+				Rand:    bytes.NewReader(bytes.Repeat([]byte{0xff}, 32)),
+			},
+			mnemonic: strings.Repeat("zoo ", 23) + "vote",
+		},
+		{
+			name: "Japanese",
+			opts: GenerateOptions{
+				Language: Japanese,
+				Rand:     bytes.NewReader(make([]byte, 16)),
+			},
+			mnemonic: strings.Repeat("あいこくしん　", 11) + "あおぞら",
+		},
+		{
+			name: "Short Read",
+			opts: GenerateOptions{
+				Rand: bytes.NewReader(make([]byte, 15)),
+			},
+			err: io.ErrUnexpectedEOF,
+		},
+		{
+			name: "Invalid Word Count",
+			opts: GenerateOptions{
+				Words: 13,
+			},
+			err: ErrInvalidWordCount,
+		},
+		{
+			name: "Unknown Language",
+			opts: GenerateOptions{
+				Language: Language(20),
+			},
+			err: ErrUnknownLanguage,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kr, err := GenerateWithOptions(tt.opts)
+
+			if err != tt.err {
+				t.Fatalf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+
+			if tt.err != nil {
+				return
+			}
+
+			mnemonic, err := kr.Mnemonic()
+
+			if err != nil {
+				t.Fatalf("Error getting mnemonic phrase: %v", err)
+			}
+
+			if !reflect.DeepEqual(mnemonicWords(mnemonic), mnemonicWords(tt.mnemonic)) {
+				t.Errorf("Invalid mnemonic, expected %v, got %v", tt.mnemonic, mnemonic)
+			}
+
+			net := tt.opts.withDefaults().Network
+
+			if kr.suri.Network != net {
+				t.Errorf("Invalid network, expected %v, got %v", net.Name(), kr.suri.Network.Name())
+			}
+		})
+	}
+}
+
+func TestFromEntropy(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		entropy  string
+		password string
+		mnemonic string
+		public   string
+		err      error
+	}{
+		{
+			name:     "Development Phrase",
+			entropy:  "1a486a5fbe53639984cb64b070755f7b",
+			mnemonic: devPhrase,
+			public:   "46ebddef8cd9bb167dc30878d7113b7e168e6f0646beffd77d69d39bad76b47a",
+		},
+		{
+			name:     "Development Phrase with Password",
+			entropy:  "1a486a5fbe53639984cb64b070755f7b",
+			password: "pass/1234",
+			mnemonic: devPhrase,
+		},
+		{
+			name:     "24 Words",
+			entropy:  "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			mnemonic: strings.Repeat("zoo ", 23) + "vote",
+		},
+		{
+			name:    "Invalid Length",
+			entropy: "1a486a5fbe53639984cb64b070755f",
+			err:     ErrInvalidEntropy,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			entropy, err := hex.DecodeString(tt.entropy)
+
+			if err != nil {
+				t.Fatalf("Error decoding entropy: %v", err)
+			}
+
+			kr, err := FromEntropy(entropy, tt.password, NetSubstrate{})
+
+			if err != tt.err {
+				t.Fatalf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+
+			if tt.err != nil {
+				return
+			}
+
+			mnemonic, err := kr.Mnemonic()
+
+			if err != nil {
+				t.Fatalf("Error getting mnemonic phrase: %v", err)
+			}
+
+			if mnemonic != tt.mnemonic {
+				t.Errorf("Invalid mnemonic, expected %v, got %v", tt.mnemonic, mnemonic)
+			}
+
+			if kr.suri.Password != tt.password {
+				t.Errorf("Invalid password, expected %v, got %v", tt.password, kr.suri.Password)
+			}
+
+			// the KeyRing matches the one created from the mnemonic
+			ref, err := FromURI((&SecretURI{Phrase: tt.mnemonic, Password: tt.password}).String(), NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error creating KeyRing: %v", err)
+			}
+
+			if kr.Public() != ref.Public() {
+				t.Errorf("Invalid public key, expected %x, got %x", ref.Public(), kr.Public())
+			}
+
+			pub := kr.Public()
+
+			if tt.public != "" && hex.EncodeToString(pub[:]) != tt.public {
+				t.Errorf("Invalid public key, expected %v, got %x", tt.public, pub)
+			}
+
+			res, err := kr.Entropy()
+
+			if err != nil {
+				t.Fatalf("Error getting entropy: %v", err)
+			}
+
+			if hex.EncodeToString(res) != tt.entropy {
+				t.Errorf("Invalid entropy, expected %v, got %x", tt.entropy, res)
+			}
+		})
+	}
+}
+
+func TestEntropy(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		suri    string
+		entropy string
+		err     error
+	}{
+		{
+			name:    "Mnemonic",
+			suri:    devPhrase,
+			entropy: "1a486a5fbe53639984cb64b070755f7b",
+		},
+		{
+			name:    "Mnemonic with Path and Password",
+			suri:    devPhrase + "//Alice/0///pass1234",
+			entropy: "1a486a5fbe53639984cb64b070755f7b",
+		},
+		{
+			name:    "Japanese Mnemonic",
+			suri:    strings.Repeat("あいこくしん　", 11) + "あおぞら",
+			entropy: "00000000000000000000000000000000",
+		},
+		{
+			name: "Secret Hex",
+			suri: "0x7202a4eba69bb283e8e9a3f5f6f0fc64bb02e6d20fb4b6bde13caec148f2cca7",
+			err:  ErrNonMnemonic,
+		},
+		{
+			name: "SS58 Address",
+			suri: "5GmkK1KwzDR5NMqxeAaTKDLXhym8QJ3pu8RsjxVaEGAxVsAo",
+			err:  ErrNonMnemonic,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kr, err := FromURI(tt.suri, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error creating KeyRing: %v", err)
+			}
+
+			res, err := kr.Entropy()
+
+			if err != tt.err {
+				t.Fatalf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+
+			if hex.EncodeToString(res) != tt.entropy {
+				t.Errorf("Invalid entropy, expected %v, got %x", tt.entropy, res)
+			}
+		})
+	}
+}
+
 func TestFromPublic(t *testing.T) {
 
 	tests := []struct {
@@ -1060,3 +1429,179 @@ func TestFromPublic(t *testing.T) {
 		})
 	}
 }
+
+func TestDerive(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		suri  string
+		paths []string
+	}{
+		{
+			name:  "Mnemonic Hard",
+			suri:  devPhrase,
+			paths: []string{"//Alice"},
+		},
+		{
+			name:  "Mnemonic Hard Incremental",
+			suri:  devPhrase,
+			paths: []string{"//polkadot", "//0", "//1"},
+		},
+		{
+			name:  "Mnemonic Mixed",
+			suri:  devPhrase + "//polkadot",
+			paths: []string{"/0", "//1"},
+		},
+		{
+			name:  "Mnemonic Password",
+			suri:  devPhrase + "//polkadot///pass1234",
+			paths: []string{"//0/1"},
+		},
+		{
+			name:  "Secret Hex",
+			suri:  "0xe5be9a5092b81bca64be81d212e7f2f9eba183bb7a90954f7b76361f6edb5c0a",
+			paths: []string{"//joe", "/1"},
+		},
+		{
+			name:  "SS58 Soft",
+			suri:  "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+			paths: []string{"/joe/polkadot", "/0"},
+		},
+		{
+			name:  "Empty Path",
+			suri:  devPhrase + "//Alice",
+			paths: []string{""},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kr, err := FromURI(tt.suri, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error creating keyring: %v", err)
+			}
+
+			kr.SetSigningContext([]byte("ctx"))
+			suri := tt.suri
+			password := ""
+
+			if i := strings.Index(suri, "///"); i >= 0 {
+				suri, password = suri[:i], suri[i:]
+			}
+
+			for _, path := range tt.paths {
+
+				kr, err = kr.Derive(path)
+
+				if err != nil {
+					t.Fatalf("Error deriving %v: %v", path, err)
+				}
+
+				suri += path
+
+				expected, err := FromURI(suri+password, NetSubstrate{})
+
+				if err != nil {
+					t.Fatalf("Error creating keyring: %v", err)
+				}
+
+				if kr.Public() != expected.Public() {
+					t.Errorf("Invalid public key for %v, expected %x, got %x", suri, expected.Public(), kr.Public())
+				}
+
+				if kr.hasSecret != expected.hasSecret {
+					t.Errorf("Invalid secret flag for %v, expected %v, got %v", suri, expected.hasSecret, kr.hasSecret)
+				}
+
+				seed, err := kr.Seed()
+				expSeed, expErr := expected.Seed()
+
+				if seed != expSeed || err != expErr {
+					t.Errorf("Invalid seed for %v, expected %x (%v), got %x (%v)", suri, expSeed, expErr, seed, err)
+				}
+
+				if string(kr.Context()) != "ctx" {
+					t.Errorf("Signing context was not kept")
+				}
+			}
+		})
+	}
+}
+
+func TestDeriveRawSecretKey(t *testing.T) {
+
+	alice, err := FromURI(devPhrase+"//Alice", NetSubstrate{})
+
+	if err != nil {
+		t.Fatalf("Error creating keyring: %v", err)
+	}
+
+	pkcs8, err := alice.encodePKCS8()
+
+	if err != nil {
+		t.Fatalf("Error encoding PKCS8: %v", err)
+	}
+
+	raw, err := decodePKCS8(pkcs8, NetSubstrate{})
+
+	if err != nil {
+		t.Fatalf("Error decoding PKCS8: %v", err)
+	}
+
+	for _, path := range []string{"//0", "/0", "//0/1"} {
+
+		kr, err := raw.Derive(path)
+
+		if err != nil {
+			t.Fatalf("Error deriving %v: %v", path, err)
+		}
+
+		expected, err := FromURI(devPhrase+"//Alice"+path, NetSubstrate{})
+
+		if err != nil {
+			t.Fatalf("Error creating keyring: %v", err)
+		}
+
+		if kr.Public() != expected.Public() {
+			t.Errorf("Invalid public key for %v, expected %x, got %x", path, expected.Public(), kr.Public())
+		}
+
+		seed, err := kr.Seed()
+		expSeed, _ := expected.Seed()
+
+		switch {
+		case path == "//0" && (err != nil || seed != expSeed):
+			t.Errorf("Invalid seed for %v, expected %x, got %x (%v)", path, expSeed, seed, err)
+
+		case path != "//0" && err != ErrSeedNotAvailable:
+			t.Errorf("Expected seed not available for %v, got %v", path, err)
+		}
+	}
+}
+
+func TestDeriveInvalid(t *testing.T) {
+
+	kr, err := FromURI(devPhrase, NetSubstrate{})
+
+	if err != nil {
+		t.Fatalf("Error creating keyring: %v", err)
+	}
+
+	if _, err := kr.Derive("Alice"); err != ErrInvalidDerivePath {
+		t.Errorf("Expected invalid derive path error, got %v", err)
+	}
+
+	pub, err := FromPublic(kr.Public(), NetSubstrate{})
+
+	if err != nil {
+		t.Fatalf("Error creating keyring: %v", err)
+	}
+
+	if _, err := pub.Derive("//Alice"); err == nil {
+		t.Errorf("Expected error hard deriving from a public key")
+	}
+}
@@ -0,0 +1,297 @@
+package srkeyring
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// KeyTypeID is the 4 byte identifier of a session key type used by Substrate
+// nodes, eg: "babe"
+type KeyTypeID [4]byte
+
+var (
+	// KeyTypeBabe is the key type for BABE block production
+	KeyTypeBabe = KeyTypeID{'b', 'a', 'b', 'e'}
+	// KeyTypeGrandpa is the key type for GRANDPA finality
+	KeyTypeGrandpa = KeyTypeID{'g', 'r', 'a', 'n'}
+	// KeyTypeImOnline is the key type for I'm Online heartbeats
+	KeyTypeImOnline = KeyTypeID{'i', 'm', 'o', 'n'}
+	// KeyTypeParachain is the key type for parachain validators
+	KeyTypeParachain = KeyTypeID{'p', 'a', 'r', 'a'}
+	// KeyTypeAssignment is the key type for parachain approval assignment
+	KeyTypeAssignment = KeyTypeID{'a', 's', 'g', 'n'}
+	// KeyTypeAuthorityDiscovery is the key type for authority discovery
+	KeyTypeAuthorityDiscovery = KeyTypeID{'a', 'u', 'd', 'i'}
+	// KeyTypeBeefy is the key type for BEEFY bridge finality
+	KeyTypeBeefy = KeyTypeID{'b', 'e', 'e', 'f'}
+
+	// keyTypeSchemes maps the known key types to the scheme used by Substrate
+	keyTypeSchemes = map[KeyTypeID]Scheme{
+		KeyTypeBabe:               Sr25519,
+		KeyTypeGrandpa:            Ed25519,
+		KeyTypeImOnline:           Sr25519,
+		KeyTypeParachain:          Sr25519,
+		KeyTypeAssignment:         Sr25519,
+		KeyTypeAuthorityDiscovery: Sr25519,
+		KeyTypeBeefy:              Ecdsa,
+	}
+
+	ErrInvalidKeyType   = errors.New("Key type must be 4 characters")
+	ErrUnknownKeyType   = errors.New("Key type has no default scheme")
+	ErrKeyNotFound      = errors.New("Key not found in keystore")
+	ErrKeystoreMismatch = errors.New("Keystore file public key does not match the Secret URI")
+)
+
+// ParseKeyTypeID returns the KeyTypeID of the 4 character string, eg: "babe"
+func ParseKeyTypeID(str string) (KeyTypeID, error) {
+	var kt KeyTypeID
+
+	if len(str) != len(kt) {
+		return kt, ErrInvalidKeyType
+	}
+
+	copy(kt[:], str)
+
+	return kt, nil
+}
+
+// String returns the key type as a string
+func (kt KeyTypeID) String() string {
+	return string(kt[:])
+}
+
+// Scheme returns the scheme used by Substrate for the key type
+func (kt KeyTypeID) Scheme() (Scheme, error) {
+	scheme, ok := keyTypeSchemes[kt]
+
+	if !ok {
+		return 0, ErrUnknownKeyType
+	}
+
+	return scheme, nil
+}
+
+// KeystoreKey identifies a key stored in the keystore
+type KeystoreKey struct {
+	// KeyType is the key type the key is stored under
+	KeyType KeyTypeID
+	// Public is the public key in raw bytes
+	Public []byte
+}
+
+// Keystore reads and writes keys in a Substrate node keystore directory.
+// Each key is stored in a file named by the hex encoded key type and public
+// key containing the JSON quoted Secret URI
+type Keystore struct {
+	// path is the keystore directory
+	path string
+	// net is the network the loaded keys are created for
+	net Network
+}
+
+// OpenKeystore returns a Keystore for the directory, creating it if it does
+// not exist
+func OpenKeystore(path string, net Network) (*Keystore, error) {
+
+	if err := os.MkdirAll(path, 0700); err != nil {
+		return nil, err
+	}
+
+	ks := &Keystore{
+		path: path,
+		net:  net,
+	}
+
+	return ks, nil
+}
+
+// Path returns the keystore directory
+func (ks *Keystore) Path() string {
+	return ks.path
+}
+
+// keyPath returns the file path of the key
+func (ks *Keystore) keyPath(kt KeyTypeID, pub []byte) string {
+	return filepath.Join(ks.path, hex.EncodeToString(kt[:])+hex.EncodeToString(pub))
+}
+
+// List returns all keys in the keystore
+func (ks *Keystore) List() ([]KeystoreKey, error) {
+
+	files, err := ioutil.ReadDir(ks.path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []KeystoreKey
+
+	for _, f := range files {
+
+		if f.IsDir() {
+			continue
+		}
+
+		b, err := hex.DecodeString(f.Name())
+
+		if err != nil || len(b) <= len(KeyTypeID{}) {
+			// not a keystore file
+			continue
+		}
+
+		var kt KeyTypeID
+		copy(kt[:], b)
+
+		keys = append(keys, KeystoreKey{
+			KeyType: kt,
+			Public:  b[len(kt):],
+		})
+	}
+
+	return keys, nil
+}
+
+// Keys returns the public keys in the keystore of the given key type
+func (ks *Keystore) Keys(kt KeyTypeID) ([][]byte, error) {
+
+	keys, err := ks.List()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var res [][]byte
+
+	for _, key := range keys {
+		if key.KeyType == kt {
+			res = append(res, key.Public)
+		}
+	}
+
+	return res, nil
+}
+
+// Insert derives the key pair from the Secret URI using the key type's
+// scheme and stores it in the keystore
+func (ks *Keystore) Insert(kt KeyTypeID, suri string) (KeyPair, error) {
+
+	scheme, err := kt.Scheme()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ks.InsertWithScheme(kt, suri, scheme)
+}
+
+// InsertWithScheme derives the key pair from the Secret URI using the given
+// scheme and stores it in the keystore.  The Secret URI must provide a secret
+// key as the node can not sign with a public key only, such as from an SS58
+// address
+func (ks *Keystore) InsertWithScheme(kt KeyTypeID, suri string, scheme Scheme) (KeyPair, error) {
+
+	kp, err := FromURIWithScheme(suri, ks.net, scheme)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasSecretKey(kp) {
+		return nil, ErrNoSecretKey
+	}
+
+	data, err := json.Marshal(suri)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = ioutil.WriteFile(ks.keyPath(kt, kp.PublicKey()), data, 0600)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return kp, nil
+}
+
+// hasSecretKey returns true if the key pair has a secret key available
+func hasSecretKey(kp KeyPair) bool {
+	switch k := kp.(type) {
+	case *KeyRing:
+		return k.hasSecret
+	case *Ed25519KeyRing:
+		return k.hasSecret
+	case *EcdsaKeyRing:
+		return k.hasSecret
+	default:
+		return false
+	}
+}
+
+// Load returns the key pair of the public key stored in the keystore using
+// the key type's scheme
+func (ks *Keystore) Load(kt KeyTypeID, pub []byte) (KeyPair, error) {
+
+	scheme, err := kt.Scheme()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ks.LoadWithScheme(kt, pub, scheme)
+}
+
+// LoadWithScheme returns the key pair of the public key stored in the
+// keystore using the given scheme
+func (ks *Keystore) LoadWithScheme(kt KeyTypeID, pub []byte, scheme Scheme) (KeyPair, error) {
+
+	data, err := ioutil.ReadFile(ks.keyPath(kt, pub))
+
+	if os.IsNotExist(err) {
+		return nil, ErrKeyNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	var suri string
+
+	if err := json.Unmarshal(data, &suri); err != nil {
+		return nil, err
+	}
+
+	kp, err := FromURIWithScheme(suri, ks.net, scheme)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(kp.PublicKey(), pub) {
+		return nil, ErrKeystoreMismatch
+	}
+
+	return kp, nil
+}
+
+// Has returns true if the public key is stored in the keystore
+func (ks *Keystore) Has(kt KeyTypeID, pub []byte) bool {
+	_, err := os.Stat(ks.keyPath(kt, pub))
+	return err == nil
+}
+
+// Remove deletes the public key from the keystore
+func (ks *Keystore) Remove(kt KeyTypeID, pub []byte) error {
+
+	err := os.Remove(ks.keyPath(kt, pub))
+
+	if os.IsNotExist(err) {
+		return ErrKeyNotFound
+	}
+
+	return err
+}
@@ -0,0 +1,298 @@
+package srkeyring
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Note: file names match the keystore files written by a Substrate node
+// started with `--alice`
+
+var keystoreTests = []struct {
+	name    string
+	keyType KeyTypeID
+	suri    string
+	file    string
+}{
+	{
+		name:    "Babe",
+		keyType: KeyTypeBabe,
+		suri:    devPhrase + "//Alice",
+		file:    "62616265d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+	},
+	{
+		name:    "Grandpa",
+		keyType: KeyTypeGrandpa,
+		suri:    devPhrase + "//Alice",
+		file:    "6772616e88dc3417d5058ec4b4503e0c12ea1a0a89be200fe98922423d4334014fa6b0ee",
+	},
+	{
+		name:    "ImOnline",
+		keyType: KeyTypeImOnline,
+		suri:    devPhrase + "//Alice",
+		file:    "696d6f6ed43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+	},
+	{
+		name:    "Parachain",
+		keyType: KeyTypeParachain,
+		suri:    devPhrase + "//Alice",
+		file:    "70617261d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+	},
+	{
+		name:    "Authority Discovery",
+		keyType: KeyTypeAuthorityDiscovery,
+		suri:    devPhrase + "//Alice",
+		file:    "61756469d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+	},
+	{
+		name:    "Beefy",
+		keyType: KeyTypeBeefy,
+		suri:    devPhrase + "//Alice",
+		file:    "62656566020a1091341fe5664bfa1782d5e04779689068c916b04cb365ec3153755684d9a1",
+	},
+}
+
+// tempKeystore returns a Keystore in a new temporary directory
+func tempKeystore(t *testing.T) *Keystore {
+
+	dir, err := ioutil.TempDir("", "srkeyring-keystore")
+
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %v", err)
+	}
+
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	ks, err := OpenKeystore(filepath.Join(dir, "keystore"), NetSubstrate{})
+
+	if err != nil {
+		t.Fatalf("Error opening keystore: %v", err)
+	}
+
+	return ks
+}
+
+func TestKeystoreInsert(t *testing.T) {
+
+	for _, tt := range keystoreTests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ks := tempKeystore(t)
+
+			kp, err := ks.Insert(tt.keyType, tt.suri)
+
+			if err != nil {
+				t.Fatalf("Error inserting key: %v", err)
+			}
+
+			data, err := ioutil.ReadFile(filepath.Join(ks.Path(), tt.file))
+
+			if err != nil {
+				t.Fatalf("Error reading keystore file: %v", err)
+			}
+
+			if string(data) != `"`+tt.suri+`"` {
+				t.Errorf("Invalid file contents, expected %q, got %s", tt.suri, data)
+			}
+
+			if !ks.Has(tt.keyType, kp.PublicKey()) {
+				t.Errorf("Expected keystore to have key")
+			}
+
+			keys, err := ks.Keys(tt.keyType)
+
+			if err != nil {
+				t.Fatalf("Error listing keys: %v", err)
+			}
+
+			if len(keys) != 1 || hex.EncodeToString(keys[0]) != tt.file[8:] {
+				t.Errorf("Invalid keys, expected %v, got %x", tt.file[8:], keys)
+			}
+
+			loaded, err := ks.Load(tt.keyType, kp.PublicKey())
+
+			if err != nil {
+				t.Fatalf("Error loading key: %v", err)
+			}
+
+			if loaded.AccountID() != kp.AccountID() {
+				t.Errorf("Invalid loaded key, expected %x, got %x", kp.AccountID(), loaded.AccountID())
+			}
+
+			if err := ks.Remove(tt.keyType, kp.PublicKey()); err != nil {
+				t.Fatalf("Error removing key: %v", err)
+			}
+
+			if ks.Has(tt.keyType, kp.PublicKey()) {
+				t.Errorf("Expected key to be removed")
+			}
+
+			if _, err := ks.Load(tt.keyType, kp.PublicKey()); err != ErrKeyNotFound {
+				t.Errorf("Expected key not found error, got %v", err)
+			}
+
+			if err := ks.Remove(tt.keyType, kp.PublicKey()); err != ErrKeyNotFound {
+				t.Errorf("Expected key not found error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestKeystoreList(t *testing.T) {
+
+	ks := tempKeystore(t)
+
+	for _, tt := range keystoreTests {
+		if _, err := ks.Insert(tt.keyType, tt.suri); err != nil {
+			t.Fatalf("Error inserting key: %v", err)
+		}
+	}
+
+	// files which are not keys are ignored
+	err := ioutil.WriteFile(filepath.Join(ks.Path(), "README"), []byte("x"), 0600)
+
+	if err != nil {
+		t.Fatalf("Error writing file: %v", err)
+	}
+
+	keys, err := ks.List()
+
+	if err != nil {
+		t.Fatalf("Error listing keys: %v", err)
+	}
+
+	if len(keys) != len(keystoreTests) {
+		t.Fatalf("Invalid key count, expected %d, got %d", len(keystoreTests), len(keys))
+	}
+
+	files := make(map[string]bool)
+
+	for _, key := range keys {
+		files[hex.EncodeToString(key.KeyType[:])+hex.EncodeToString(key.Public)] = true
+	}
+
+	for _, tt := range keystoreTests {
+		if !files[tt.file] {
+			t.Errorf("Key %v not listed", tt.file)
+		}
+	}
+
+	bob, err := ks.Insert(KeyTypeBabe, devPhrase+"//Bob")
+
+	if err != nil {
+		t.Fatalf("Error inserting key: %v", err)
+	}
+
+	babe, err := ks.Keys(KeyTypeBabe)
+
+	if err != nil {
+		t.Fatalf("Error listing keys: %v", err)
+	}
+
+	if len(babe) != 2 {
+		t.Errorf("Invalid babe key count, expected 2, got %d", len(babe))
+	}
+
+	if _, err := ks.Load(KeyTypeBabe, bob.PublicKey()); err != nil {
+		t.Errorf("Error loading key: %v", err)
+	}
+}
+
+func TestKeystoreInvalid(t *testing.T) {
+
+	ks := tempKeystore(t)
+	custom := KeyTypeID{'t', 'e', 's', 't'}
+
+	if _, err := ks.Insert(custom, devPhrase); err != ErrUnknownKeyType {
+		t.Errorf("Expected unknown key type error, got %v", err)
+	}
+
+	kp, err := ks.InsertWithScheme(custom, devPhrase, Ed25519)
+
+	if err != nil {
+		t.Fatalf("Error inserting key: %v", err)
+	}
+
+	if _, err := ks.LoadWithScheme(custom, kp.PublicKey(), Ed25519); err != nil {
+		t.Errorf("Error loading key: %v", err)
+	}
+
+	// a key loaded with the wrong scheme does not match the file name
+	if _, err := ks.LoadWithScheme(custom, kp.PublicKey(), Sr25519); err != ErrKeystoreMismatch {
+		t.Errorf("Expected keystore mismatch error, got %v", err)
+	}
+
+	// public only Secret URIs can not be signed with so are not stored
+	for _, suri := range []string{aliceAddress, aliceAddress + "/0"} {
+		if _, err := ks.Insert(KeyTypeBabe, suri); err != ErrNoSecretKey {
+			t.Errorf("Expected no secret key error for %v, got %v", suri, err)
+		}
+	}
+
+	if _, err := ks.InsertWithScheme(KeyTypeGrandpa, aliceAddress, Ed25519); err != ErrNoSecretKey {
+		t.Errorf("Expected no secret key error, got %v", err)
+	}
+
+	keys, err := ks.List()
+
+	if err != nil {
+		t.Fatalf("Error listing keys: %v", err)
+	}
+
+	if len(keys) != 1 {
+		t.Errorf("Expected only the secret key to be stored, got %d keys", len(keys))
+	}
+}
+
+func TestParseKeyTypeID(t *testing.T) {
+
+	tests := []struct {
+		name string
+		str  string
+		kt   KeyTypeID
+		err  error
+	}{
+		{
+			name: "Babe",
+			str:  "babe",
+			kt:   KeyTypeBabe,
+		},
+		{
+			name: "Beefy",
+			str:  "beef",
+			kt:   KeyTypeBeefy,
+		},
+		{
+			name: "Too Short",
+			str:  "bab",
+			err:  ErrInvalidKeyType,
+		},
+		{
+			name: "Too Long",
+			str:  "babes",
+			err:  ErrInvalidKeyType,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kt, err := ParseKeyTypeID(tt.str)
+
+			if err != tt.err {
+				t.Fatalf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+
+			if err == nil && (kt != tt.kt || kt.String() != tt.str) {
+				t.Errorf("Invalid key type, expected %v, got %v", tt.kt, kt)
+			}
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package srkeyring
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// ideographicSpace is the word separator used for Japanese mnemonics as
+	// recommended by BIP39
+	ideographicSpace = "\u3000"
+
+	// mnemonicIterations is the number of PBKDF2 rounds used to create the
+	// seed from the mnemonic entropy
+	mnemonicIterations = 2048
+)
+
+var (
+	ErrUnknownLanguage = errors.New("Unknown mnemonic language")
+	ErrUnknownWord     = errors.New("Mnemonic contains a word not in the wordlist")
+	ErrInvalidChecksum = errors.New("Mnemonic checksum is invalid")
+	ErrInvalidEntropy  = errors.New("Entropy length must be 16, 20, 24, 28, or 32 bytes")
+)
+
+// Language specifies the BIP39 wordlist language of a mnemonic
+type Language int
+
+const (
+	English Language = iota + 1
+	Japanese
+	Korean
+	ChineseSimplified
+	ChineseTraditional
+	Spanish
+	French
+	Italian
+	Czech
+)
+
+// languages lists the supported languages in the order they are tried when
+// detecting the language of a mnemonic.  Chinese Simplified is tried before
+// Traditional as the wordlists share many characters
+var languages = []Language{
+	English,
+	Japanese,
+	Korean,
+	ChineseSimplified,
+	ChineseTraditional,
+	Spanish,
+	French,
+	Italian,
+	Czech,
+}
+
+// languageNames are the names of each Language
+var languageNames = map[Language]string{
+	English:            "english",
+	Japanese:           "japanese",
+	Korean:             "korean",
+	ChineseSimplified:  "chinese_simplified",
+	ChineseTraditional: "chinese_traditional",
+	Spanish:            "spanish",
+	French:             "french",
+	Italian:            "italian",
+	Czech:              "czech",
+}
+
+// wordlist is a BIP39 wordlist with the NFKD normalized words indexed for
+// lookup
+type wordlist struct {
+	words []string
+	index map[string]int
+}
+
+var (
+	wordlistsOnce sync.Once
+	wordlistCache map[Language]*wordlist
+)
+
+// String returns the language name
+func (l Language) String() string {
+	if name, ok := languageNames[l]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Language(%d)", int(l))
+}
+
+// ParseLanguage returns the Language for the given language name
+func ParseLanguage(name string) (Language, error) {
+
+	name = strings.ToLower(name)
+
+	for l, n := range languageNames {
+		if n == name {
+			return l, nil
+		}
+	}
+
+	return 0, ErrUnknownLanguage
+}
+
+// separator returns the separator placed between words of a mnemonic
+func (l Language) separator() string {
+	if l == Japanese {
+		return ideographicSpace
+	}
+
+	return " "
+}
+
+// getWordlist returns the wordlist of the language
+func getWordlist(lang Language) (*wordlist, error) {
+
+	wordlistsOnce.Do(func() {
+		lists := map[Language][]string{
+			English:            wordlists.English,
+			Japanese:           wordlists.Japanese,
+			Korean:             wordlists.Korean,
+			ChineseSimplified:  wordlists.ChineseSimplified,
+			ChineseTraditional: wordlists.ChineseTraditional,
+			Spanish:            wordlists.Spanish,
+			French:             wordlists.French,
+			Italian:            wordlists.Italian,
+			Czech:              wordlists.Czech,
+		}
+
+		wordlistCache = make(map[Language]*wordlist, len(lists))
+
+		for l, words := range lists {
+			wl := &wordlist{
+				words: words,
+				index: make(map[string]int, len(words)),
+			}
+
+			for i, w := range words {
+				wl.index[norm.NFKD.String(w)] = i
+			}
+
+			wordlistCache[l] = wl
+		}
+	})
+
+	wl, ok := wordlistCache[lang]
+
+	if !ok {
+		return nil, ErrUnknownLanguage
+	}
+
+	return wl, nil
+}
+
+// mnemonicWords returns the NFKD normalized words of the mnemonic
+func mnemonicWords(mnemonic string) []string {
+	return strings.Fields(normalizeMnemonic(mnemonic))
+}
+
+// DetectLanguage returns the language of the wordlist containing all words
+// of the mnemonic.  When the words are found in more than one wordlist the
+// first language the mnemonic checksum is valid for is returned
+func DetectLanguage(mnemonic string) (Language, error) {
+
+	langs, err := wordlistLanguages(mnemonicWords(mnemonic))
+
+	if err != nil {
+		return 0, err
+	}
+
+	for _, lang := range langs {
+		if _, err := mnemonicToEntropy(mnemonic, lang); err == nil {
+			return lang, nil
+		}
+	}
+
+	return langs[0], nil
+}
+
+// wordlistLanguages returns the languages whose wordlist contains all of the
+// words, in the order of languages
+func wordlistLanguages(words []string) ([]Language, error) {
+
+	var langs []Language
+
+	if len(words) == 0 {
+		return nil, ErrUnknownLanguage
+	}
+
+	for _, lang := range languages {
+		wl, err := getWordlist(lang)
+
+		if err != nil {
+			return nil, err
+		}
+
+		found := true
+
+		for _, w := range words {
+			if _, ok := wl.index[w]; !ok {
+				found = false
+				break
+			}
+		}
+
+		if found {
+			langs = append(langs, lang)
+		}
+	}
+
+	if len(langs) == 0 {
+		return nil, ErrUnknownLanguage
+	}
+
+	return langs, nil
+}
+
+// entropyToMnemonic returns the BIP39 mnemonic of the entropy using the
+// wordlist of the language
+func entropyToMnemonic(entropy []byte, lang Language) (string, error) {
+
+	bits := len(entropy) * 8
+
+	if _, ok := wordsForEntropy(bits); !ok {
+		return "", ErrInvalidEntropy
+	}
+
+	wl, err := getWordlist(lang)
+
+	if err != nil {
+		return "", err
+	}
+
+	// the checksum is the first entropy length / 32 bits of the sha256 hash
+	csBits := bits / 32
+	hash := sha256.Sum256(entropy)
+
+	b := new(big.Int).SetBytes(entropy)
+	b.Lsh(b, uint(csBits))
+	b.Or(b, big.NewInt(int64(hash[0]>>(8-csBits))))
+
+	count := (bits + csBits) / 11
+	words := make([]string, count)
+	mask := big.NewInt(2047)
+
+	for i := count - 1; i >= 0; i-- {
+		idx := new(big.Int).And(b, mask)
+		words[i] = wl.words[idx.Int64()]
+		b.Rsh(b, 11)
+	}
+
+	return strings.Join(words, lang.separator()), nil
+}
+
+// mnemonicToEntropy returns the entropy of the BIP39 mnemonic after validating
+// its words and checksum against the wordlist of the language
+func mnemonicToEntropy(mnemonic string, lang Language) ([]byte, error) {
+
+	wl, err := getWordlist(lang)
+
+	if err != nil {
+		return nil, err
+	}
+
+	words := mnemonicWords(mnemonic)
+	bitsize, ok := entropyWords[WordCount(len(words))]
+
+	if !ok {
+		return nil, ErrInvalidWordCount
+	}
+
+	b := new(big.Int)
+
+	for _, w := range words {
+		idx, ok := wl.index[w]
+
+		if !ok {
+			return nil, ErrUnknownWord
+		}
+
+		b.Lsh(b, 11)
+		b.Or(b, big.NewInt(int64(idx)))
+	}
+
+	csBits := uint(bitsize) / 32
+	checksum := new(big.Int).And(b, big.NewInt(int64(1)<<csBits-1))
+	b.Rsh(b, csBits)
+
+	entropy := make([]byte, bitsize/8)
+	eb := b.Bytes()
+	copy(entropy[len(entropy)-len(eb):], eb)
+
+	hash := sha256.Sum256(entropy)
+
+	if checksum.Int64() != int64(hash[0]>>(8-csBits)) {
+		return nil, ErrInvalidChecksum
+	}
+
+	return entropy, nil
+}
+
+// wordsForEntropy returns the number of mnemonic words for the given bits of
+// entropy
+func wordsForEntropy(bits int) (WordCount, bool) {
+	for wc, eb := range entropyWords {
+		if int(eb) == bits {
+			return wc, true
+		}
+	}
+
+	return 0, false
+}
+
+// mnemonicSeed returns the seed of the mnemonic in any supported language.
+// As done by Substrate the seed is created from the mnemonic entropy rather
+// than the mnemonic words, so the same entropy creates the same seed in every
+// language
+func mnemonicSeed(mnemonic, password string) ([64]byte, error) {
+
+	lang, err := DetectLanguage(mnemonic)
+
+	if err != nil {
+		// report errors against the English wordlist when no language
+		// matches, such as from a misspelt word
+		lang = English
+	}
+
+	entropy, err := mnemonicToEntropy(mnemonic, lang)
+
+	if err != nil {
+		return [64]byte{}, err
+	}
+
+	return entropySeed(entropy, password), nil
+}
+
+// entropySeed returns the seed of the mnemonic entropy and password.
+// See https://github.com/paritytech/substrate-bip39/blob/master/src/lib.rs
+// function seed_from_entropy()
+func entropySeed(entropy []byte, password string) [64]byte {
+	var seed [64]byte
+
+	copy(seed[:], pbkdf2.Key(entropy, []byte("mnemonic"+password), mnemonicIterations, 64, sha512.New))
+
+	return seed
+}
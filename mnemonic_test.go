@@ -0,0 +1,304 @@
+package srkeyring
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+
+	sr25519 "github.com/ChainSafe/go-schnorrkel"
+)
+
+// Note: test vectors are from the BIP39 specification test vectors at
+// https://github.com/trezor/python-mnemonic/blob/master/vectors.json and
+// https://github.com/bip32JP/bip32JP.github.io/blob/master/test_JP_BIP39.json
+
+func TestEntropyToMnemonic(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		entropy  string
+		lang     Language
+		mnemonic string
+	}{
+		{
+			name:     "English Zero",
+			entropy:  "00000000000000000000000000000000",
+			lang:     English,
+			mnemonic: strings.Repeat("abandon ", 11) + "about",
+		},
+		{
+			name:     "English 7f",
+			entropy:  "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+			lang:     English,
+			mnemonic: "legal winner thank year wave sausage worth useful legal winner thank yellow",
+		},
+		{
+			name:     "English 80",
+			entropy:  "80808080808080808080808080808080",
+			lang:     English,
+			mnemonic: "letter advice cage absurd amount doctor acoustic avoid letter advice cage above",
+		},
+		{
+			name:     "English 24 Words",
+			entropy:  "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			lang:     English,
+			mnemonic: strings.Repeat("zoo ", 23) + "vote",
+		},
+		{
+			name:     "Japanese Zero",
+			entropy:  "00000000000000000000000000000000",
+			lang:     Japanese,
+			mnemonic: strings.Repeat("あいこくしん　", 11) + "あおぞら",
+		},
+		{
+			name:     "Spanish Zero",
+			entropy:  "00000000000000000000000000000000",
+			lang:     Spanish,
+			mnemonic: strings.Repeat("ábaco ", 11) + "abierto",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			entropy, err := hex.DecodeString(tt.entropy)
+
+			if err != nil {
+				t.Fatalf("Error decoding entropy: %v", err)
+			}
+
+			mnemonic, err := entropyToMnemonic(entropy, tt.lang)
+
+			if err != nil {
+				t.Fatalf("Error creating mnemonic: %v", err)
+			}
+
+			// wordlists are NFKD normalized so accented characters are
+			// decomposed, and Japanese words are separated by an ideographic
+			// space
+			if !reflect.DeepEqual(strings.Split(mnemonic, tt.lang.separator()), mnemonicWords(tt.mnemonic)) {
+				t.Errorf("Invalid mnemonic, expected %v, got %v", tt.mnemonic, mnemonic)
+			}
+
+			res, err := mnemonicToEntropy(mnemonic, tt.lang)
+
+			if err != nil {
+				t.Fatalf("Error getting entropy: %v", err)
+			}
+
+			if hex.EncodeToString(res) != tt.entropy {
+				t.Errorf("Invalid entropy, expected %v, got %x", tt.entropy, res)
+			}
+
+			lang, err := DetectLanguage(mnemonic)
+
+			if err != nil {
+				t.Fatalf("Error detecting language: %v", err)
+			}
+
+			if lang != tt.lang {
+				t.Errorf("Invalid language, expected %v, got %v", tt.lang, lang)
+			}
+		})
+	}
+}
+
+func TestMnemonicLanguages(t *testing.T) {
+
+	entropy := make([]byte, 32)
+
+	if _, err := rand.Read(entropy); err != nil {
+		t.Fatalf("Error creating entropy: %v", err)
+	}
+
+	english, err := entropyToMnemonic(entropy, English)
+
+	if err != nil {
+		t.Fatalf("Error creating mnemonic: %v", err)
+	}
+
+	// the English seed matches the go-schnorrkel Substrate implementation
+	expected, err := sr25519.SeedFromMnemonic(english, "pass1234")
+
+	if err != nil {
+		t.Fatalf("Error creating seed: %v", err)
+	}
+
+	for _, lang := range languages {
+		lang := lang // capture range variable
+		t.Run(lang.String(), func(t *testing.T) {
+			t.Parallel()
+
+			mnemonic, err := entropyToMnemonic(entropy, lang)
+
+			if err != nil {
+				t.Fatalf("Error creating mnemonic: %v", err)
+			}
+
+			res, err := mnemonicToEntropy(mnemonic, lang)
+
+			if err != nil {
+				t.Fatalf("Error getting entropy: %v", err)
+			}
+
+			if hex.EncodeToString(res) != hex.EncodeToString(entropy) {
+				t.Errorf("Invalid entropy, expected %x, got %x", entropy, res)
+			}
+
+			// the same entropy creates the same key in every language
+			seed, err := mnemonicSeed(mnemonic, "pass1234")
+
+			if err != nil {
+				t.Fatalf("Error creating seed: %v", err)
+			}
+
+			if seed != expected {
+				t.Errorf("Invalid seed, expected %x, got %x", expected, seed)
+			}
+
+			kr, err := FromURI(mnemonic+"//0///pass1234", NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error creating keyring: %v", err)
+			}
+
+			ref, err := FromURI(english+"//0///pass1234", NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error creating keyring: %v", err)
+			}
+
+			if kr.Public() != ref.Public() {
+				t.Errorf("Invalid public key, expected %x, got %x", ref.Public(), kr.Public())
+			}
+		})
+	}
+}
+
+func TestGenerateWithLanguage(t *testing.T) {
+
+	for _, lang := range languages {
+		lang := lang // capture range variable
+		t.Run(lang.String(), func(t *testing.T) {
+			t.Parallel()
+
+			kr, err := GenerateWithLanguage(18, lang, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error generating keyring: %v", err)
+			}
+
+			mnemonic, err := kr.Mnemonic()
+
+			if err != nil {
+				t.Fatalf("Error getting mnemonic: %v", err)
+			}
+
+			if n := len(mnemonicWords(mnemonic)); n != 18 {
+				t.Errorf("Invalid word count, expected 18, got %d", n)
+			}
+
+			res, err := DetectLanguage(mnemonic)
+
+			if err != nil {
+				t.Fatalf("Error detecting language: %v", err)
+			}
+
+			if res != lang {
+				t.Errorf("Invalid language, expected %v, got %v", lang, res)
+			}
+		})
+	}
+
+	if _, err := GenerateWithLanguage(13, English, NetSubstrate{}); err != ErrInvalidWordCount {
+		t.Errorf("Expected invalid word count error, got %v", err)
+	}
+
+	if _, err := GenerateWithLanguage(12, Language(20), NetSubstrate{}); err != ErrUnknownLanguage {
+		t.Errorf("Expected unknown language error, got %v", err)
+	}
+
+	// unset arguments are not defaulted as done by GenerateWithOptions
+	if _, err := GenerateWithLanguage(0, English, NetSubstrate{}); err != ErrInvalidWordCount {
+		t.Errorf("Expected invalid word count error, got %v", err)
+	}
+
+	if _, err := GenerateWithLanguage(12, 0, NetSubstrate{}); err != ErrUnknownLanguage {
+		t.Errorf("Expected unknown language error, got %v", err)
+	}
+
+	if _, err := GenerateWithLanguage(12, English, nil); err != ErrNoNetwork {
+		t.Errorf("Expected no network error, got %v", err)
+	}
+}
+
+func TestMnemonicInvalid(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		mnemonic string
+		err      error
+	}{
+		{
+			name:     "Unknown Word",
+			mnemonic: "bottom drive obey lake curtain smoke basket hold race lonely fit wallk",
+			err:      ErrUnknownWord,
+		},
+		{
+			name:     "Invalid Checksum",
+			mnemonic: "bottom drive obey lake curtain smoke basket hold race lonely fit fit",
+			err:      ErrInvalidChecksum,
+		},
+		{
+			name:     "Invalid Word Count",
+			mnemonic: "bottom drive obey lake curtain smoke basket hold race lonely fit",
+			err:      ErrInvalidWordCount,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := mnemonicSeed(tt.mnemonic, ""); err != tt.err {
+				t.Errorf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+
+			if _, err := FromURI(tt.mnemonic, NetSubstrate{}); err != tt.err {
+				t.Errorf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+
+	if _, err := entropyToMnemonic(make([]byte, 15), English); err != ErrInvalidEntropy {
+		t.Errorf("Expected invalid entropy error, got %v", err)
+	}
+
+	if _, err := DetectLanguage("not bip39 words"); err != ErrUnknownLanguage {
+		t.Errorf("Expected unknown language error, got %v", err)
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+
+	for _, lang := range languages {
+		res, err := ParseLanguage(strings.ToUpper(lang.String()))
+
+		if err != nil {
+			t.Fatalf("Error parsing language %v: %v", lang, err)
+		}
+
+		if res != lang {
+			t.Errorf("Invalid language, expected %v, got %v", lang, res)
+		}
+	}
+
+	if _, err := ParseLanguage("klingon"); err != ErrUnknownLanguage {
+		t.Errorf("Expected unknown language error, got %v", err)
+	}
+}
@@ -0,0 +1,148 @@
+package srkeyring
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sort"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// utilityPrefix is the prefix hashed by pallet-multisig and pallet-utility,
+// where multisig originated, to create multisig and derivative account ids
+const utilityPrefix = "modlpy/utilisuba"
+
+var (
+	ErrTooFewSignatories  = errors.New("Multisig requires at least two signatories")
+	ErrDuplicateSignatory = errors.New("Multisig signatories contain a duplicate account")
+	ErrInvalidThreshold   = errors.New("Multisig threshold must be between 1 and the number of signatories")
+)
+
+// Multisig is a pallet-multisig account defined by its signatories and the
+// number of them required to approve a call
+type Multisig struct {
+	// Signatories are the account ids of the signatories in sorted order
+	Signatories [][32]byte
+	// Threshold is the number of signatories required to approve a call
+	Threshold uint16
+}
+
+// NewMultisig returns the Multisig for the given signatory account ids and
+// threshold.  The signatories may be given in any order, and at least two are
+// required as done by pallet-multisig
+func NewMultisig(signatories [][32]byte, threshold uint16) (*Multisig, error) {
+
+	if len(signatories) < 2 {
+		return nil, ErrTooFewSignatories
+	}
+
+	if threshold == 0 || int(threshold) > len(signatories) {
+		return nil, ErrInvalidThreshold
+	}
+
+	sorted := make([][32]byte, len(signatories))
+	copy(sorted, signatories)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i][:], sorted[j][:]) < 0
+	})
+
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i] == sorted[i-1] {
+			return nil, ErrDuplicateSignatory
+		}
+	}
+
+	m := &Multisig{
+		Signatories: sorted,
+		Threshold:   threshold,
+	}
+
+	return m, nil
+}
+
+// MultisigFromAddresses returns the Multisig for the given signatory SS58
+// addresses, which may be encoded for any network
+func MultisigFromAddresses(addresses []string, threshold uint16) (*Multisig, error) {
+
+	signatories := make([][32]byte, 0, len(addresses))
+
+	for _, addr := range addresses {
+		acc, _, err := DecodeAnySS58Address(addr, SS58Checksum)
+
+		if err != nil {
+			return nil, err
+		}
+
+		signatories = append(signatories, acc)
+	}
+
+	return NewMultisig(signatories, threshold)
+}
+
+// MultisigFromKeyPairs returns the Multisig for the account ids of the given
+// signatory key pairs
+func MultisigFromKeyPairs(kps []KeyPair, threshold uint16) (*Multisig, error) {
+
+	signatories := make([][32]byte, 0, len(kps))
+
+	for _, kp := range kps {
+		signatories = append(signatories, kp.AccountID())
+	}
+
+	return NewMultisig(signatories, threshold)
+}
+
+// AccountID returns the multisig account id which is the blake2b 256 hash of
+// the prefix "modlpy/utilisuba", SCALE encoded sorted signatories, and the
+// little endian threshold.
+// See https://github.com/paritytech/substrate/blob/master/frame/multisig/src/lib.rs
+// function multi_account_id()
+func (m *Multisig) AccountID() ([32]byte, error) {
+
+	l, err := compactUint(uint64(len(m.Signatories)))
+
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	buf := append([]byte(utilityPrefix), l...)
+
+	for _, s := range m.Signatories {
+		buf = append(buf, s[:]...)
+	}
+
+	var threshold [2]byte
+	binary.LittleEndian.PutUint16(threshold[:], m.Threshold)
+	buf = append(buf, threshold[:]...)
+
+	return blake2b.Sum256(buf), nil
+}
+
+// SS58Address returns the multisig account SS58 address for the network
+func (m *Multisig) SS58Address(net Network) (string, error) {
+
+	acc, err := m.AccountID()
+
+	if err != nil {
+		return "", err
+	}
+
+	return SS58Address(acc, net, SS58Checksum)
+}
+
+// OtherSignatories returns the sorted signatories excluding the given
+// account, as passed to the multisig pallet calls made by that signatory
+func (m *Multisig) OtherSignatories(who [32]byte) [][32]byte {
+
+	others := make([][32]byte, 0, len(m.Signatories))
+
+	for _, s := range m.Signatories {
+		if s != who {
+			others = append(others, s)
+		}
+	}
+
+	return others
+}
@@ -0,0 +1,183 @@
+package srkeyring
+
+import (
+	"testing"
+)
+
+// dev account addresses on the Substrate network
+const (
+	aliceAddress   = "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"
+	bobAddress     = "5FHneW46xGXgs5mUiveU4sbTyGBzmstUspZC92UhjJM694ty"
+	charlieAddress = "5FLSigC9HGRKVhB9FiEo4Y3koPsNmBmLJbpXg2mp1hXcS59Y"
+)
+
+func TestMultisigFromAddresses(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		addresses []string
+		threshold uint16
+		net       Network
+		expected  string
+	}{
+		{
+			// matches polkadot-js createKeyMulti()
+			name:      "Alice Bob Charlie 2",
+			addresses: []string{aliceAddress, bobAddress, charlieAddress},
+			threshold: 2,
+			net:       NetSubstrate{},
+			expected:  "5DjYJStmdZ2rcqXbXGX7TW85JsrW6uG4y9MUcLq2BoPMpRA7",
+		},
+		{
+			name:      "Unsorted",
+			addresses: []string{charlieAddress, aliceAddress, bobAddress},
+			threshold: 2,
+			net:       NetSubstrate{},
+			expected:  "5DjYJStmdZ2rcqXbXGX7TW85JsrW6uG4y9MUcLq2BoPMpRA7",
+		},
+		{
+			name:      "Polkadot Encoded Signatory",
+			addresses: []string{"15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5", bobAddress, charlieAddress},
+			threshold: 2,
+			net:       NetSubstrate{},
+			expected:  "5DjYJStmdZ2rcqXbXGX7TW85JsrW6uG4y9MUcLq2BoPMpRA7",
+		},
+		{
+			name:      "Alice Bob 2",
+			addresses: []string{aliceAddress, bobAddress},
+			threshold: 2,
+			net:       NetSubstrate{},
+			expected:  "5F3QVbS78a4aTYLiRAD8N3czjqVoNyV42L19CXyhqUMCh4Ch",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := MultisigFromAddresses(tt.addresses, tt.threshold)
+
+			if err != nil {
+				t.Fatalf("Error creating multisig: %v", err)
+			}
+
+			addr, err := m.SS58Address(tt.net)
+
+			if err != nil {
+				t.Fatalf("Error getting SS58 Address: %v", err)
+			}
+
+			if addr != tt.expected {
+				t.Errorf("Invalid address, expected %v, got %v", tt.expected, addr)
+			}
+
+			for i := 1; i < len(m.Signatories); i++ {
+				if string(m.Signatories[i-1][:]) >= string(m.Signatories[i][:]) {
+					t.Errorf("Signatories are not sorted")
+				}
+			}
+		})
+	}
+}
+
+func TestMultisigFromKeyPairs(t *testing.T) {
+
+	var kps []KeyPair
+
+	for _, suri := range []string{devPhrase + "//Alice", devPhrase + "//Bob", devPhrase + "//Charlie"} {
+		kr, err := FromURI(suri, NetSubstrate{})
+
+		if err != nil {
+			t.Fatalf("Error creating keyring: %v", err)
+		}
+
+		kps = append(kps, kr)
+	}
+
+	m, err := MultisigFromKeyPairs(kps, 2)
+
+	if err != nil {
+		t.Fatalf("Error creating multisig: %v", err)
+	}
+
+	acc, err := m.AccountID()
+
+	if err != nil {
+		t.Fatalf("Error getting account id: %v", err)
+	}
+
+	expected := "0x49daa32c7287890f38b7e1a8cd2961723d36d20baa0bf3b82e0c4bdda93b1c0a"
+
+	if EncodeHex(acc[:], "0x") != expected {
+		t.Errorf("Invalid account id, expected %v, got %x", expected, acc)
+	}
+
+	others := m.OtherSignatories(kps[0].AccountID())
+
+	if len(others) != 2 {
+		t.Fatalf("Invalid other signatories count, expected 2, got %d", len(others))
+	}
+
+	for _, o := range others {
+		if o == kps[0].AccountID() {
+			t.Errorf("Other signatories contain the excluded account")
+		}
+	}
+}
+
+func TestMultisigInvalid(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		addresses []string
+		threshold uint16
+		err       error
+	}{
+		{
+			name:      "No Signatories",
+			addresses: []string{},
+			threshold: 1,
+			err:       ErrTooFewSignatories,
+		},
+		{
+			name:      "One Signatory",
+			addresses: []string{aliceAddress},
+			threshold: 1,
+			err:       ErrTooFewSignatories,
+		},
+		{
+			name:      "Zero Threshold",
+			addresses: []string{aliceAddress, bobAddress},
+			threshold: 0,
+			err:       ErrInvalidThreshold,
+		},
+		{
+			name:      "Threshold Too High",
+			addresses: []string{aliceAddress, bobAddress},
+			threshold: 3,
+			err:       ErrInvalidThreshold,
+		},
+		{
+			name:      "Duplicate",
+			addresses: []string{aliceAddress, bobAddress, aliceAddress},
+			threshold: 2,
+			err:       ErrDuplicateSignatory,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := MultisigFromAddresses(tt.addresses, tt.threshold); err != tt.err {
+				t.Errorf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+
+	if _, err := MultisigFromAddresses([]string{aliceAddress, "invalid"}, 1); err == nil {
+		t.Errorf("Expected error for invalid address")
+	}
+}
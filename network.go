@@ -3,7 +3,7 @@ package srkeyring
 // Network defines the interface for a specific networks settings to be used
 // for key generation and address formatting
 type Network interface {
-	// Name returns the network name used in the KeyRing SigningContext transcript
+	// Name returns the network name
 	Name() string
 	// Version returns the network version number used in SS58 address formatting
 	// see https://github.com/paritytech/substrate/wiki/External-Address-Format-(SS58)#checksum-types
@@ -23,6 +23,27 @@ type Network interface {
 	ChecksumEnd() int
 }
 
+// WideNetwork is an optional interface a Network can implement to declare
+// an SS58 address prefix greater than 63 which is encoded using two bytes.
+// When implemented the value returned by Prefix() is used in place of
+// Version() for SS58 address formatting
+type WideNetwork interface {
+	Network
+	// Prefix returns the network prefix used in SS58 address formatting.
+	// Valid ranges are 0 to 16383
+	Prefix() uint16
+}
+
+// NetworkPrefix returns the SS58 address prefix for the given Network, using
+// the wide prefix if the Network implements the WideNetwork interface
+func NetworkPrefix(net Network) uint16 {
+	if wn, ok := net.(WideNetwork); ok {
+		return wn.Prefix()
+	}
+
+	return uint16(net.Version())
+}
+
 // force Substrate to implement Network interface
 var _ Network = &NetSubstrate{}
 
@@ -30,7 +51,7 @@ var _ Network = &NetSubstrate{}
 // settings
 type NetSubstrate struct{}
 
-// Name returns the network name used in the KeyRing SigningContext transcript
+// Name returns the network name
 func (n NetSubstrate) Name() string {
 	return "substrate"
 }
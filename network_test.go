@@ -43,3 +43,36 @@ func TestNetSubstrate(t *testing.T) {
 		})
 	}
 }
+
+func TestNetworkPrefix(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		net    Network
+		prefix uint16
+	}{
+		{
+			name:   "Substrate",
+			net:    NetSubstrate{},
+			prefix: 42,
+		},
+		{
+			name:   "Wide Network",
+			net:    netWide{prefix: 7391},
+			prefix: 7391,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prefix := NetworkPrefix(tt.net)
+
+			if prefix != tt.prefix {
+				t.Errorf("Wrong network prefix, expected %v, got %v", tt.prefix, prefix)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package srkeyring
+
+import (
+	"encoding/binary"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// pureProxyPrefix is the prefix hashed by pallet-proxy to create the account
+// id of a pure (formerly anonymous) proxy
+const pureProxyPrefix = "modlpy/proxy____"
+
+// PureProxy defines the inputs of a pallet-proxy create_pure call which
+// determine the pure proxy account id
+type PureProxy struct {
+	// Spawner is the account id of the account which called create_pure
+	Spawner [32]byte
+	// ProxyType is the index of the runtime's ProxyType enum variant, eg: 0
+	// for Any on Polkadot and Kusama
+	ProxyType uint8
+	// Index is the disambiguation index passed to create_pure
+	Index uint16
+	// Height is the block number the create_pure extrinsic was included in
+	Height uint32
+	// ExtrinsicIndex is the index of the create_pure extrinsic in the block
+	ExtrinsicIndex uint32
+}
+
+// AccountID returns the pure proxy account id which is the blake2b 256 hash
+// of the prefix "modlpy/proxy____", the spawner, block height, extrinsic
+// index, proxy type and index.  This assumes the runtime uses a u32 block
+// number and a single byte ProxyType encoding as Polkadot and Kusama do.
+// See https://github.com/paritytech/substrate/blob/master/frame/proxy/src/lib.rs
+// function pure_account()
+func (p PureProxy) AccountID() [32]byte {
+
+	buf := append([]byte(pureProxyPrefix), p.Spawner[:]...)
+	buf = append(buf, encodeU32(p.Height)...)
+	buf = append(buf, encodeU32(p.ExtrinsicIndex)...)
+	buf = append(buf, p.ProxyType)
+
+	var index [2]byte
+	binary.LittleEndian.PutUint16(index[:], p.Index)
+	buf = append(buf, index[:]...)
+
+	return blake2b.Sum256(buf)
+}
+
+// SS58Address returns the pure proxy SS58 address for the network
+func (p PureProxy) SS58Address(net Network) (string, error) {
+	return SS58Address(p.AccountID(), net, SS58Checksum)
+}
@@ -0,0 +1,72 @@
+package srkeyring
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+func TestPureProxy(t *testing.T) {
+
+	alice, _, err := DecodeAnySS58Address(aliceAddress, SS58Checksum)
+
+	if err != nil {
+		t.Fatalf("Error decoding address: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		proxy    PureProxy
+		preimage string
+		expected string
+	}{
+		{
+			name:  "Alice Any",
+			proxy: PureProxy{Spawner: alice},
+			preimage: "6d6f646c70792f70726f78795f5f5f5f" + aliceSr25519 +
+				"00000000" + "00000000" + "00" + "0000",
+			expected: "5FyHcmKVnpK2r6FY4F4wmyrWGwoi55hTqj3zPNHk62Sv5PY1",
+		},
+		{
+			name: "Alice NonTransfer",
+			proxy: PureProxy{
+				Spawner:        alice,
+				ProxyType:      1,
+				Index:          2,
+				Height:         100,
+				ExtrinsicIndex: 3,
+			},
+			preimage: "6d6f646c70792f70726f78795f5f5f5f" + aliceSr25519 +
+				"64000000" + "03000000" + "01" + "0200",
+			expected: "5Fy7qVkQR2WRpJYwfjjSQ3WNe8QJtKQkckPmofpGscBpRk8R",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			preimage, err := hex.DecodeString(tt.preimage)
+
+			if err != nil {
+				t.Fatalf("Error decoding preimage: %v", err)
+			}
+
+			if acc := tt.proxy.AccountID(); acc != blake2b.Sum256(preimage) {
+				t.Errorf("Invalid account id %x, does not match preimage hash", acc)
+			}
+
+			addr, err := tt.proxy.SS58Address(NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error getting SS58 Address: %v", err)
+			}
+
+			if addr != tt.expected {
+				t.Errorf("Invalid address, expected %v, got %v", tt.expected, addr)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+//go:build ignore
+// +build ignore
+
+// gen generates networks.go from the ss58-registry.json file.  To update the
+// bundled networks download the latest registry from
+// https://github.com/paritytech/ss58-registry/blob/main/ss58-registry.json
+// into this directory and run `go generate`.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"text/template"
+
+	"github.com/swdee/srkeyring/registry"
+)
+
+const (
+	// registryFile is the ss58-registry JSON file to generate from
+	registryFile = "ss58-registry.json"
+	// outputFile is the generated Go source file
+	outputFile = "networks.go"
+)
+
+// tmpl is the template for the generated Go source file
+var tmpl = template.Must(template.New("networks").Parse(`// Code generated by go generate; DO NOT EDIT.
+// Source: ss58-registry.json
+
+package registry
+
+// networks is the list of networks generated from the ss58-registry
+var networks = []*Network{
+{{- range .}}
+	{
+		SS58Prefix:      {{.SS58Prefix}},
+		Network:         {{printf "%q" .Network}},
+		DisplayName:     {{printf "%q" .DisplayName}},
+		Symbols:         []string{ {{- range $i, $s := .Symbols}}{{if $i}}, {{end}}{{printf "%q" $s}}{{end -}} },
+		Decimals:        []int{ {{- range $i, $d := .Decimals}}{{if $i}}, {{end}}{{$d}}{{end -}} },
+		StandardAccount: {{printf "%q" .StandardAccount}},
+		Website:         {{printf "%q" .Website}},
+	},
+{{- end}}
+}
+`))
+
+func main() {
+
+	reg, err := registry.LoadFile(registryFile)
+
+	if err != nil {
+		log.Fatalf("Error loading registry: %v", err)
+	}
+
+	var buf bytes.Buffer
+
+	if err := tmpl.Execute(&buf, reg.Networks()); err != nil {
+		log.Fatalf("Error executing template: %v", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+
+	if err != nil {
+		log.Fatalf("Error formatting source: %v", err)
+	}
+
+	if err := ioutil.WriteFile(outputFile, src, 0644); err != nil {
+		log.Fatalf("Error writing %v: %v", outputFile, err)
+	}
+}
@@ -0,0 +1,998 @@
+// Code generated by go generate; DO NOT EDIT.
+// Source: ss58-registry.json
+
+package registry
+
+// networks is the list of networks generated from the ss58-registry
+var networks = []*Network{
+	{
+		SS58Prefix:      0,
+		Network:         "polkadot",
+		DisplayName:     "Polkadot Relay Chain",
+		Symbols:         []string{"DOT"},
+		Decimals:        []int{10},
+		StandardAccount: "*25519",
+		Website:         "https://polkadot.network",
+	},
+	{
+		SS58Prefix:      1,
+		Network:         "BareSr25519",
+		DisplayName:     "Bare 32-bit Schnorr/Ristretto (S/R 25519) public key.",
+		Symbols:         []string{},
+		Decimals:        []int{},
+		StandardAccount: "Sr25519",
+		Website:         "",
+	},
+	{
+		SS58Prefix:      2,
+		Network:         "kusama",
+		DisplayName:     "Kusama Relay Chain",
+		Symbols:         []string{"KSM"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://kusama.network",
+	},
+	{
+		SS58Prefix:      3,
+		Network:         "BareEd25519",
+		DisplayName:     "Bare 32-bit Ed25519 public key.",
+		Symbols:         []string{},
+		Decimals:        []int{},
+		StandardAccount: "Ed25519",
+		Website:         "",
+	},
+	{
+		SS58Prefix:      4,
+		Network:         "katalchain",
+		DisplayName:     "Katal Chain",
+		Symbols:         []string{},
+		Decimals:        []int{},
+		StandardAccount: "*25519",
+		Website:         "",
+	},
+	{
+		SS58Prefix:      5,
+		Network:         "astar",
+		DisplayName:     "Astar Network",
+		Symbols:         []string{"ASTR"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://astar.network",
+	},
+	{
+		SS58Prefix:      6,
+		Network:         "bifrost",
+		DisplayName:     "Bifrost",
+		Symbols:         []string{"BNC"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://bifrost.finance/",
+	},
+	{
+		SS58Prefix:      7,
+		Network:         "edgeware",
+		DisplayName:     "Edgeware",
+		Symbols:         []string{"EDG"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://edgewa.re",
+	},
+	{
+		SS58Prefix:      8,
+		Network:         "karura",
+		DisplayName:     "Karura",
+		Symbols:         []string{"KAR"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://karura.network/",
+	},
+	{
+		SS58Prefix:      9,
+		Network:         "reynolds",
+		DisplayName:     "Laminar Reynolds Canary",
+		Symbols:         []string{"REY"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "http://laminar.network/",
+	},
+	{
+		SS58Prefix:      10,
+		Network:         "acala",
+		DisplayName:     "Acala",
+		Symbols:         []string{"ACA"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://acala.network/",
+	},
+	{
+		SS58Prefix:      11,
+		Network:         "laminar",
+		DisplayName:     "Laminar",
+		Symbols:         []string{"LAMI"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "http://laminar.network/",
+	},
+	{
+		SS58Prefix:      12,
+		Network:         "polymesh",
+		DisplayName:     "Polymesh",
+		Symbols:         []string{"POLYX"},
+		Decimals:        []int{6},
+		StandardAccount: "*25519",
+		Website:         "https://polymath.network/",
+	},
+	{
+		SS58Prefix:      13,
+		Network:         "integritee",
+		DisplayName:     "Integritee",
+		Symbols:         []string{"TEER"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://integritee.network",
+	},
+	{
+		SS58Prefix:      14,
+		Network:         "totem",
+		DisplayName:     "Totem",
+		Symbols:         []string{"TOTEM"},
+		Decimals:        []int{0},
+		StandardAccount: "*25519",
+		Website:         "https://totemaccounting.com",
+	},
+	{
+		SS58Prefix:      15,
+		Network:         "synesthesia",
+		DisplayName:     "Synesthesia",
+		Symbols:         []string{"SYN"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://synesthesia.network/",
+	},
+	{
+		SS58Prefix:      16,
+		Network:         "kulupu",
+		DisplayName:     "Kulupu",
+		Symbols:         []string{"KLP"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://kulupu.network/",
+	},
+	{
+		SS58Prefix:      17,
+		Network:         "dark",
+		DisplayName:     "Dark Mainnet",
+		Symbols:         []string{},
+		Decimals:        []int{},
+		StandardAccount: "*25519",
+		Website:         "",
+	},
+	{
+		SS58Prefix:      18,
+		Network:         "darwinia",
+		DisplayName:     "Darwinia Network",
+		Symbols:         []string{"RING"},
+		Decimals:        []int{18},
+		StandardAccount: "secp256k1",
+		Website:         "https://darwinia.network",
+	},
+	{
+		SS58Prefix:      19,
+		Network:         "watr",
+		DisplayName:     "Watr Protocol",
+		Symbols:         []string{"WATR"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://www.watr.org",
+	},
+	{
+		SS58Prefix:      20,
+		Network:         "stafi",
+		DisplayName:     "Stafi",
+		Symbols:         []string{"FIS"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://stafi.io",
+	},
+	{
+		SS58Prefix:      22,
+		Network:         "dock-pos-mainnet",
+		DisplayName:     "Dock Mainnet",
+		Symbols:         []string{"DCK"},
+		Decimals:        []int{6},
+		StandardAccount: "*25519",
+		Website:         "https://dock.io",
+	},
+	{
+		SS58Prefix:      23,
+		Network:         "shift",
+		DisplayName:     "ShiftNrg",
+		Symbols:         []string{},
+		Decimals:        []int{},
+		StandardAccount: "*25519",
+		Website:         "",
+	},
+	{
+		SS58Prefix:      24,
+		Network:         "zero",
+		DisplayName:     "ZERO",
+		Symbols:         []string{"ZERO"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://zero.io",
+	},
+	{
+		SS58Prefix:      25,
+		Network:         "zero-alphaville",
+		DisplayName:     "ZERO Alphaville",
+		Symbols:         []string{"ZERO"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "",
+	},
+	{
+		SS58Prefix:      26,
+		Network:         "jupiter",
+		DisplayName:     "Jupiter",
+		Symbols:         []string{"jDOT"},
+		Decimals:        []int{10},
+		StandardAccount: "*25519",
+		Website:         "https://jupiter.patract.io",
+	},
+	{
+		SS58Prefix:      28,
+		Network:         "subsocial",
+		DisplayName:     "Subsocial",
+		Symbols:         []string{},
+		Decimals:        []int{},
+		StandardAccount: "*25519",
+		Website:         "",
+	},
+	{
+		SS58Prefix:      29,
+		Network:         "cord",
+		DisplayName:     "CORD Network",
+		Symbols:         []string{"DHI", "WAY"},
+		Decimals:        []int{12, 12},
+		StandardAccount: "*25519",
+		Website:         "https://cord.network/",
+	},
+	{
+		SS58Prefix:      30,
+		Network:         "phala",
+		DisplayName:     "Phala Network",
+		Symbols:         []string{"PHA"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://phala.network",
+	},
+	{
+		SS58Prefix:      31,
+		Network:         "litentry",
+		DisplayName:     "Litentry Network",
+		Symbols:         []string{"LIT"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://litentry.com/",
+	},
+	{
+		SS58Prefix:      32,
+		Network:         "robonomics",
+		DisplayName:     "Robonomics",
+		Symbols:         []string{"XRT"},
+		Decimals:        []int{9},
+		StandardAccount: "*25519",
+		Website:         "https://robonomics.network",
+	},
+	{
+		SS58Prefix:      33,
+		Network:         "datahighway",
+		DisplayName:     "DataHighway",
+		Symbols:         []string{},
+		Decimals:        []int{},
+		StandardAccount: "*25519",
+		Website:         "",
+	},
+	{
+		SS58Prefix:      34,
+		Network:         "ares",
+		DisplayName:     "Ares Protocol",
+		Symbols:         []string{"ARES"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://www.aresprotocol.com/",
+	},
+	{
+		SS58Prefix:      35,
+		Network:         "vln",
+		DisplayName:     "Valiu Liquidity Network",
+		Symbols:         []string{"USDv"},
+		Decimals:        []int{15},
+		StandardAccount: "*25519",
+		Website:         "https://valiu.com/",
+	},
+	{
+		SS58Prefix:      36,
+		Network:         "centrifuge",
+		DisplayName:     "Centrifuge Chain",
+		Symbols:         []string{"CFG"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://centrifuge.io/",
+	},
+	{
+		SS58Prefix:      37,
+		Network:         "nodle",
+		DisplayName:     "Nodle Chain",
+		Symbols:         []string{"NODL"},
+		Decimals:        []int{11},
+		StandardAccount: "*25519",
+		Website:         "https://nodle.io/",
+	},
+	{
+		SS58Prefix:      38,
+		Network:         "kilt",
+		DisplayName:     "KILT Spiritnet",
+		Symbols:         []string{"KILT"},
+		Decimals:        []int{15},
+		StandardAccount: "*25519",
+		Website:         "https://kilt.io/",
+	},
+	{
+		SS58Prefix:      39,
+		Network:         "mathchain",
+		DisplayName:     "MathChain mainnet",
+		Symbols:         []string{"MATH"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://mathwallet.org",
+	},
+	{
+		SS58Prefix:      40,
+		Network:         "mathchain-testnet",
+		DisplayName:     "MathChain testnet",
+		Symbols:         []string{"MATH"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://mathwallet.org",
+	},
+	{
+		SS58Prefix:      41,
+		Network:         "poli",
+		DisplayName:     "Polimec Protocol",
+		Symbols:         []string{"PLMC"},
+		Decimals:        []int{10},
+		StandardAccount: "*25519",
+		Website:         "https://www.polimec.org/",
+	},
+	{
+		SS58Prefix:      42,
+		Network:         "substrate",
+		DisplayName:     "Substrate",
+		Symbols:         []string{},
+		Decimals:        []int{},
+		StandardAccount: "*25519",
+		Website:         "https://substrate.io/",
+	},
+	{
+		SS58Prefix:      43,
+		Network:         "BareSecp256k1",
+		DisplayName:     "Bare 32-bit ECDSA SECP-256k1 public key.",
+		Symbols:         []string{},
+		Decimals:        []int{},
+		StandardAccount: "secp256k1",
+		Website:         "",
+	},
+	{
+		SS58Prefix:      44,
+		Network:         "chainx",
+		DisplayName:     "ChainX",
+		Symbols:         []string{"PCX"},
+		Decimals:        []int{8},
+		StandardAccount: "*25519",
+		Website:         "https://chainx.org/",
+	},
+	{
+		SS58Prefix:      45,
+		Network:         "uniarts",
+		DisplayName:     "UniArts Network",
+		Symbols:         []string{"UART", "UINK"},
+		Decimals:        []int{12, 12},
+		StandardAccount: "*25519",
+		Website:         "https://uniarts.me",
+	},
+	{
+		SS58Prefix:      46,
+		Network:         "reserved46",
+		DisplayName:     "This prefix is reserved.",
+		Symbols:         []string{},
+		Decimals:        []int{},
+		StandardAccount: "",
+		Website:         "",
+	},
+	{
+		SS58Prefix:      47,
+		Network:         "reserved47",
+		DisplayName:     "This prefix is reserved.",
+		Symbols:         []string{},
+		Decimals:        []int{},
+		StandardAccount: "",
+		Website:         "",
+	},
+	{
+		SS58Prefix:      48,
+		Network:         "neatcoin",
+		DisplayName:     "Neatcoin Mainnet",
+		Symbols:         []string{"NEAT"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://neatcoin.org",
+	},
+	{
+		SS58Prefix:      49,
+		Network:         "picasso",
+		DisplayName:     "Picasso",
+		Symbols:         []string{"PICA"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://picasso.composable.finance",
+	},
+	{
+		SS58Prefix:      50,
+		Network:         "composable",
+		DisplayName:     "Composable Finance",
+		Symbols:         []string{"LAYR"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://composable.finance",
+	},
+	{
+		SS58Prefix:      51,
+		Network:         "oak",
+		DisplayName:     "OAK Network",
+		Symbols:         []string{"OAK"},
+		Decimals:        []int{10},
+		StandardAccount: "*25519",
+		Website:         "https://oak.tech",
+	},
+	{
+		SS58Prefix:      52,
+		Network:         "KICO",
+		DisplayName:     "KICO",
+		Symbols:         []string{"KICO"},
+		Decimals:        []int{14},
+		StandardAccount: "*25519",
+		Website:         "https://dico.io",
+	},
+	{
+		SS58Prefix:      53,
+		Network:         "DICO",
+		DisplayName:     "DICO",
+		Symbols:         []string{"DICO"},
+		Decimals:        []int{14},
+		StandardAccount: "*25519",
+		Website:         "https://dico.io",
+	},
+	{
+		SS58Prefix:      54,
+		Network:         "cere",
+		DisplayName:     "Cere Network",
+		Symbols:         []string{"CERE"},
+		Decimals:        []int{10},
+		StandardAccount: "*25519",
+		Website:         "https://cere.network",
+	},
+	{
+		SS58Prefix:      55,
+		Network:         "xxnetwork",
+		DisplayName:     "xx network",
+		Symbols:         []string{"XX"},
+		Decimals:        []int{9},
+		StandardAccount: "*25519",
+		Website:         "https://xx.network",
+	},
+	{
+		SS58Prefix:      56,
+		Network:         "pendulum",
+		DisplayName:     "Pendulum chain",
+		Symbols:         []string{"PEN"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://pendulumchain.org/",
+	},
+	{
+		SS58Prefix:      57,
+		Network:         "amplitude",
+		DisplayName:     "Amplitude chain",
+		Symbols:         []string{"AMPE"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://pendulumchain.org/",
+	},
+	{
+		SS58Prefix:      63,
+		Network:         "hydradx",
+		DisplayName:     "HydraDX",
+		Symbols:         []string{"HDX"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://hydradx.io",
+	},
+	{
+		SS58Prefix:      65,
+		Network:         "aventus",
+		DisplayName:     "Aventus Mainnet",
+		Symbols:         []string{"AVT"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://aventus.io",
+	},
+	{
+		SS58Prefix:      66,
+		Network:         "crust",
+		DisplayName:     "Crust Network",
+		Symbols:         []string{"CRU"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://crust.network",
+	},
+	{
+		SS58Prefix:      67,
+		Network:         "genshiro",
+		DisplayName:     "Genshiro Network",
+		Symbols:         []string{"GENS", "EQD", "LPT0"},
+		Decimals:        []int{9, 9, 9},
+		StandardAccount: "*25519",
+		Website:         "https://genshiro.equilibrium.io",
+	},
+	{
+		SS58Prefix:      68,
+		Network:         "equilibrium",
+		DisplayName:     "Equilibrium Network",
+		Symbols:         []string{"EQ"},
+		Decimals:        []int{9},
+		StandardAccount: "*25519",
+		Website:         "https://equilibrium.io",
+	},
+	{
+		SS58Prefix:      69,
+		Network:         "sora",
+		DisplayName:     "SORA Network",
+		Symbols:         []string{"XOR"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://sora.org",
+	},
+	{
+		SS58Prefix:      73,
+		Network:         "zeitgeist",
+		DisplayName:     "Zeitgeist",
+		Symbols:         []string{"ZTG"},
+		Decimals:        []int{10},
+		StandardAccount: "*25519",
+		Website:         "https://zeitgeist.pm",
+	},
+	{
+		SS58Prefix:      77,
+		Network:         "manta",
+		DisplayName:     "Manta network",
+		Symbols:         []string{"MANTA"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://manta.network",
+	},
+	{
+		SS58Prefix:      78,
+		Network:         "calamari",
+		DisplayName:     "Calamari: Manta Canary Network",
+		Symbols:         []string{"KMA"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://manta.network",
+	},
+	{
+		SS58Prefix:      88,
+		Network:         "polkadex",
+		DisplayName:     "Polkadex Mainnet",
+		Symbols:         []string{"PDEX"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://polkadex.trade",
+	},
+	{
+		SS58Prefix:      90,
+		Network:         "frequency",
+		DisplayName:     "Frequency",
+		Symbols:         []string{"FRQCY"},
+		Decimals:        []int{8},
+		StandardAccount: "*25519",
+		Website:         "https://www.frequency.xyz",
+	},
+	{
+		SS58Prefix:      98,
+		Network:         "polkasmith",
+		DisplayName:     "PolkaSmith Canary Network",
+		Symbols:         []string{"PKS"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://polkafoundry.com",
+	},
+	{
+		SS58Prefix:      99,
+		Network:         "polkafoundry",
+		DisplayName:     "PolkaFoundry Network",
+		Symbols:         []string{"PKF"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://polkafoundry.com",
+	},
+	{
+		SS58Prefix:      101,
+		Network:         "origintrail-parachain",
+		DisplayName:     "OriginTrail Parachain",
+		Symbols:         []string{"OTP"},
+		Decimals:        []int{12},
+		StandardAccount: "secp256k1",
+		Website:         "https://parachain.origintrail.io/",
+	},
+	{
+		SS58Prefix:      105,
+		Network:         "pontem-network",
+		DisplayName:     "Pontem Network",
+		Symbols:         []string{"PONT"},
+		Decimals:        []int{10},
+		StandardAccount: "*25519",
+		Website:         "https://pontem.network",
+	},
+	{
+		SS58Prefix:      110,
+		Network:         "heiko",
+		DisplayName:     "Heiko",
+		Symbols:         []string{"HKO"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://parallel.fi/",
+	},
+	{
+		SS58Prefix:      117,
+		Network:         "tinker",
+		DisplayName:     "Tinker",
+		Symbols:         []string{"TNKR"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://invarch.network",
+	},
+	{
+		SS58Prefix:      128,
+		Network:         "clover",
+		DisplayName:     "Clover Finance",
+		Symbols:         []string{"CLV"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://clover.finance",
+	},
+	{
+		SS58Prefix:      131,
+		Network:         "litmus",
+		DisplayName:     "Litmus Network",
+		Symbols:         []string{"LIT"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://litentry.com/",
+	},
+	{
+		SS58Prefix:      136,
+		Network:         "altair",
+		DisplayName:     "Altair",
+		Symbols:         []string{"AIR"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://centrifuge.io/",
+	},
+	{
+		SS58Prefix:      137,
+		Network:         "vara",
+		DisplayName:     "Vara Network",
+		Symbols:         []string{"VARA"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://vara.network/",
+	},
+	{
+		SS58Prefix:      172,
+		Network:         "parallel",
+		DisplayName:     "Parallel",
+		Symbols:         []string{"PARA"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://parallel.fi/",
+	},
+	{
+		SS58Prefix:      252,
+		Network:         "social-network",
+		DisplayName:     "Social Network",
+		Symbols:         []string{"NET"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://social.network",
+	},
+	{
+		SS58Prefix:      255,
+		Network:         "quartz_mainnet",
+		DisplayName:     "QUARTZ by UNIQUE",
+		Symbols:         []string{"QTZ"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://unique.network",
+	},
+	{
+		SS58Prefix:      268,
+		Network:         "pioneer_network",
+		DisplayName:     "Pioneer Network by Bit.Country",
+		Symbols:         []string{"NEER"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://bit.country",
+	},
+	{
+		SS58Prefix:      420,
+		Network:         "sora_kusama_para",
+		DisplayName:     "SORA Kusama Parachain",
+		Symbols:         []string{"XOR"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://sora.org",
+	},
+	{
+		SS58Prefix:      789,
+		Network:         "geek",
+		DisplayName:     "GEEK Network",
+		Symbols:         []string{"GEEK"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://geek.gl",
+	},
+	{
+		SS58Prefix:      1110,
+		Network:         "efinity",
+		DisplayName:     "Efinity",
+		Symbols:         []string{"EFI"},
+		Decimals:        []int{18},
+		StandardAccount: "Sr25519",
+		Website:         "https://efinity.io/",
+	},
+	{
+		SS58Prefix:      1221,
+		Network:         "peaq",
+		DisplayName:     "Peaq Network",
+		Symbols:         []string{"PEAQ"},
+		Decimals:        []int{18},
+		StandardAccount: "Sr25519",
+		Website:         "https://www.peaq.network/",
+	},
+	{
+		SS58Prefix:      1284,
+		Network:         "moonbeam",
+		DisplayName:     "Moonbeam",
+		Symbols:         []string{"GLMR"},
+		Decimals:        []int{18},
+		StandardAccount: "secp256k1",
+		Website:         "https://moonbeam.network",
+	},
+	{
+		SS58Prefix:      1285,
+		Network:         "moonriver",
+		DisplayName:     "Moonriver",
+		Symbols:         []string{"MOVR"},
+		Decimals:        []int{18},
+		StandardAccount: "secp256k1",
+		Website:         "https://moonbeam.network",
+	},
+	{
+		SS58Prefix:      1328,
+		Network:         "ajuna",
+		DisplayName:     "Ajuna Network",
+		Symbols:         []string{"AJUN"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://ajuna.io",
+	},
+	{
+		SS58Prefix:      1337,
+		Network:         "bajun",
+		DisplayName:     "Bajun Network",
+		Symbols:         []string{"BAJU"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://ajuna.io",
+	},
+	{
+		SS58Prefix:      2007,
+		Network:         "kapex",
+		DisplayName:     "Kapex",
+		Symbols:         []string{"KAPEX"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://totemaccounting.com",
+	},
+	{
+		SS58Prefix:      2032,
+		Network:         "interlay",
+		DisplayName:     "Interlay",
+		Symbols:         []string{"INTR"},
+		Decimals:        []int{10},
+		StandardAccount: "*25519",
+		Website:         "https://interlay.io/",
+	},
+	{
+		SS58Prefix:      2092,
+		Network:         "kintsugi",
+		DisplayName:     "Kintsugi",
+		Symbols:         []string{"KINT"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://interlay.io/",
+	},
+	{
+		SS58Prefix:      2106,
+		Network:         "bitgreen",
+		DisplayName:     "Bitgreen",
+		Symbols:         []string{"BBB"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://bitgreen.org/",
+	},
+	{
+		SS58Prefix:      2112,
+		Network:         "chainflip",
+		DisplayName:     "Chainflip",
+		Symbols:         []string{"FLIP"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://chainflip.io/",
+	},
+	{
+		SS58Prefix:      2206,
+		Network:         "ICE",
+		DisplayName:     "ICE Network",
+		Symbols:         []string{"ICY"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://icenetwork.io",
+	},
+	{
+		SS58Prefix:      2207,
+		Network:         "SNOW",
+		DisplayName:     "SNOW: ICE Canary Network",
+		Symbols:         []string{"ICZ"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://icenetwork.io",
+	},
+	{
+		SS58Prefix:      2254,
+		Network:         "subspace_testnet",
+		DisplayName:     "Subspace testnet",
+		Symbols:         []string{"tSSC"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://subspace.network",
+	},
+	{
+		SS58Prefix:      6094,
+		Network:         "subspace",
+		DisplayName:     "Subspace",
+		Symbols:         []string{"SSC"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://subspace.network",
+	},
+	{
+		SS58Prefix:      7007,
+		Network:         "tidefi",
+		DisplayName:     "Tidefi",
+		Symbols:         []string{"TDFY"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://tidefi.com",
+	},
+	{
+		SS58Prefix:      7013,
+		Network:         "gm",
+		DisplayName:     "GM",
+		Symbols:         []string{"FREN", "GM", "GN"},
+		Decimals:        []int{12, 0, 0},
+		StandardAccount: "*25519",
+		Website:         "https://gmordie.com",
+	},
+	{
+		SS58Prefix:      7391,
+		Network:         "unique_mainnet",
+		DisplayName:     "Unique Network",
+		Symbols:         []string{"UNQ"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://unique.network",
+	},
+	{
+		SS58Prefix:      8883,
+		Network:         "sapphire_mainnet",
+		DisplayName:     "Sapphire by Unique",
+		Symbols:         []string{"QTZ"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://unique.network",
+	},
+	{
+		SS58Prefix:      9807,
+		Network:         "dentnet",
+		DisplayName:     "DENTNet",
+		Symbols:         []string{"DENTX"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://www.dentnet.io",
+	},
+	{
+		SS58Prefix:      9935,
+		Network:         "t3rn",
+		DisplayName:     "t3rn",
+		Symbols:         []string{"TRN"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://t3rn.io/",
+	},
+	{
+		SS58Prefix:      10041,
+		Network:         "basilisk",
+		DisplayName:     "Basilisk",
+		Symbols:         []string{"BSX"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://bsx.fi",
+	},
+	{
+		SS58Prefix:      11330,
+		Network:         "cess-testnet",
+		DisplayName:     "CESS Testnet",
+		Symbols:         []string{"TCESS"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://cess.cloud",
+	},
+	{
+		SS58Prefix:      11331,
+		Network:         "cess",
+		DisplayName:     "CESS",
+		Symbols:         []string{"CESS"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://cess.cloud",
+	},
+	{
+		SS58Prefix:      11820,
+		Network:         "contextfree",
+		DisplayName:     "Automata ContextFree",
+		Symbols:         []string{"CTX"},
+		Decimals:        []int{18},
+		StandardAccount: "*25519",
+		Website:         "https://ata.network",
+	},
+	{
+		SS58Prefix:      12191,
+		Network:         "nftmart",
+		DisplayName:     "NFTMart",
+		Symbols:         []string{"NMT"},
+		Decimals:        []int{12},
+		StandardAccount: "*25519",
+		Website:         "https://nftmart.io",
+	},
+	{
+		SS58Prefix:      13116,
+		Network:         "bittensor",
+		DisplayName:     "Bittensor",
+		Symbols:         []string{"TAO"},
+		Decimals:        []int{9},
+		StandardAccount: "*25519",
+		Website:         "https://bittensor.com",
+	},
+}
@@ -0,0 +1,273 @@
+// Package registry provides Network implementations for the chains listed
+// in the ss58-registry
+// https://github.com/paritytech/ss58-registry/blob/main/ss58-registry.json
+//
+// The bundled networks are generated from the ss58-registry.json file in this
+// directory by running `go generate`.  An updated registry file can also be
+// loaded at runtime with LoadFile() and set as the default with SetDefault().
+package registry
+
+//go:generate go run gen.go
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/swdee/srkeyring"
+)
+
+const (
+	// maxPrefix is the highest network prefix that can be SS58 encoded
+	maxPrefix = 16383
+)
+
+var (
+	ErrUnknownPrefix  = errors.New("No network found in registry for the given prefix")
+	ErrUnknownNetwork = errors.New("No network found in registry for the given name")
+
+	// defaultRegistry is the Registry used by the package level lookup
+	// functions
+	defaultRegistry = mustNew(networks)
+	// defaultMu guards access to defaultRegistry
+	defaultMu sync.RWMutex
+)
+
+// Account types used in the standardAccount field of the registry
+const (
+	AccountAny25519  = "*25519"
+	AccountSr25519   = "Sr25519"
+	AccountEd25519   = "Ed25519"
+	AccountSecp256k1 = "secp256k1"
+)
+
+// force Network to implement srkeyring WideNetwork interface
+var _ srkeyring.WideNetwork = &Network{}
+
+// Network defines a single entry in the ss58-registry and implements the
+// srkeyring WideNetwork interface
+type Network struct {
+	// SS58Prefix is the SS58 address prefix of the network
+	SS58Prefix uint16 `json:"prefix"`
+	// Network is the unique name of the network
+	Network string `json:"network"`
+	// DisplayName is the human readable name of the network
+	DisplayName string `json:"displayName"`
+	// Symbols are the token symbols of the network
+	Symbols []string `json:"symbols"`
+	// Decimals are the number of decimals for each token in Symbols
+	Decimals []int `json:"decimals"`
+	// StandardAccount is the account type used on the network, one of
+	// "*25519", "Sr25519", "Ed25519", "secp256k1", or empty if unspecified
+	StandardAccount string `json:"standardAccount"`
+	// Website is the website of the network
+	Website string `json:"website"`
+}
+
+// Name returns the network name
+func (n Network) Name() string {
+	return n.Network
+}
+
+// Version returns the network version number used in SS58 address formatting.
+// Networks with a prefix greater than 63 return zero as their prefix is
+// given by Prefix()
+func (n Network) Version() uint8 {
+	if n.SS58Prefix > 63 {
+		return 0
+	}
+
+	return uint8(n.SS58Prefix)
+}
+
+// Prefix returns the network prefix used in SS58 address formatting
+func (n Network) Prefix() uint16 {
+	return n.SS58Prefix
+}
+
+// AddressPrefix returns a prefix to apply to hex encoded addresses for
+// public key and private seed
+func (n Network) AddressPrefix() srkeyring.HexPrefix {
+	return "0x"
+}
+
+// ChecksumStart is the starting byte position of the blake2d checksum
+// calculated when generating the SS58 address checksum
+func (n Network) ChecksumStart() int {
+	return 0
+}
+
+// ChecksumEnd is the end byte position of the blake2d checksum
+// calculated when generating the SS58 address checksum
+func (n Network) ChecksumEnd() int {
+	return 2
+}
+
+// Registry holds a set of networks indexed by prefix and name
+type Registry struct {
+	// networks is the list of networks in the order given
+	networks []*Network
+	// byPrefix indexes the networks by SS58 prefix
+	byPrefix map[uint16]*Network
+	// byName indexes the networks by network name
+	byName map[string]*Network
+}
+
+// registryFile defines the structure of the ss58-registry JSON file
+type registryFile struct {
+	Registry []*Network `json:"registry"`
+}
+
+// New returns a Registry from the given list of networks
+func New(nets []*Network) (*Registry, error) {
+
+	r := &Registry{
+		networks: make([]*Network, 0, len(nets)),
+		byPrefix: make(map[uint16]*Network, len(nets)),
+		byName:   make(map[string]*Network, len(nets)),
+	}
+
+	for _, n := range nets {
+
+		if n.Network == "" {
+			return nil, fmt.Errorf("network with prefix %v has no name", n.SS58Prefix)
+		}
+
+		if n.SS58Prefix > maxPrefix {
+			return nil, fmt.Errorf("network %v has invalid prefix: %v", n.Network, n.SS58Prefix)
+		}
+
+		if len(n.Symbols) != len(n.Decimals) {
+			return nil, fmt.Errorf("network %v has mismatched symbols and decimals", n.Network)
+		}
+
+		if _, ok := r.byPrefix[n.SS58Prefix]; ok {
+			return nil, fmt.Errorf("duplicate network prefix: %v", n.SS58Prefix)
+		}
+
+		if _, ok := r.byName[n.Network]; ok {
+			return nil, fmt.Errorf("duplicate network name: %v", n.Network)
+		}
+
+		r.networks = append(r.networks, n)
+		r.byPrefix[n.SS58Prefix] = n
+		r.byName[n.Network] = n
+	}
+
+	return r, nil
+}
+
+// mustNew returns a Registry from the given list of networks and panics
+// if they are invalid
+func mustNew(nets []*Network) *Registry {
+	r, err := New(nets)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// Load returns a Registry from the ss58-registry JSON read from r
+func Load(r io.Reader) (*Registry, error) {
+
+	data := &registryFile{}
+
+	if err := json.NewDecoder(r).Decode(data); err != nil {
+		return nil, err
+	}
+
+	return New(data.Registry)
+}
+
+// LoadFile returns a Registry from the ss58-registry JSON file at path
+func LoadFile(path string) (*Registry, error) {
+
+	data, err := ioutil.ReadFile(path) // #nosec G304 path is supplied by caller
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Load(bytes.NewReader(data))
+}
+
+// ByPrefix returns the network with the given SS58 prefix
+func (r *Registry) ByPrefix(prefix uint16) (*Network, error) {
+	if n, ok := r.byPrefix[prefix]; ok {
+		return n, nil
+	}
+
+	return nil, ErrUnknownPrefix
+}
+
+// ByName returns the network with the given name
+func (r *Registry) ByName(name string) (*Network, error) {
+	if n, ok := r.byName[name]; ok {
+		return n, nil
+	}
+
+	return nil, ErrUnknownNetwork
+}
+
+// ByNameFold returns the network with the given name matched
+// case-insensitively, eg: "kico" returns the "KICO" network.  An exact match
+// is preferred if names differ only by case
+func (r *Registry) ByNameFold(name string) (*Network, error) {
+	if n, ok := r.byName[name]; ok {
+		return n, nil
+	}
+
+	for _, n := range r.networks {
+		if strings.EqualFold(n.Network, name) {
+			return n, nil
+		}
+	}
+
+	return nil, ErrUnknownNetwork
+}
+
+// Networks returns all networks in the Registry
+func (r *Registry) Networks() []*Network {
+	return append([]*Network{}, r.networks...)
+}
+
+// Default returns the Registry used by the package level lookup functions
+func Default() *Registry {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+
+	return defaultRegistry
+}
+
+// SetDefault replaces the Registry used by the package level lookup functions,
+// such as with one loaded by LoadFile() from an updated ss58-registry
+func SetDefault(r *Registry) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+
+	defaultRegistry = r
+}
+
+// ByPrefix returns the network with the given SS58 prefix from the default
+// Registry
+func ByPrefix(prefix uint16) (*Network, error) {
+	return Default().ByPrefix(prefix)
+}
+
+// ByName returns the network with the given name from the default Registry
+func ByName(name string) (*Network, error) {
+	return Default().ByName(name)
+}
+
+// ByNameFold returns the network with the given name matched
+// case-insensitively from the default Registry
+func ByNameFold(name string) (*Network, error) {
+	return Default().ByNameFold(name)
+}
@@ -0,0 +1,397 @@
+package registry
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/swdee/srkeyring"
+)
+
+func TestByPrefix(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		prefix   uint16
+		network  string
+		symbols  []string
+		decimals []int
+		account  string
+		valid    bool
+	}{
+		{
+			name:     "Polkadot",
+			prefix:   0,
+			network:  "polkadot",
+			symbols:  []string{"DOT"},
+			decimals: []int{10},
+			account:  AccountAny25519,
+			valid:    true,
+		},
+		{
+			name:     "Substrate",
+			prefix:   42,
+			network:  "substrate",
+			symbols:  []string{},
+			decimals: []int{},
+			account:  AccountAny25519,
+			valid:    true,
+		},
+		{
+			name:     "Moonbeam",
+			prefix:   1284,
+			network:  "moonbeam",
+			symbols:  []string{"GLMR"},
+			decimals: []int{18},
+			account:  AccountSecp256k1,
+			valid:    true,
+		},
+		{
+			name:     "Darwinia",
+			prefix:   18,
+			network:  "darwinia",
+			symbols:  []string{"RING"},
+			decimals: []int{18},
+			account:  AccountSecp256k1,
+			valid:    true,
+		},
+		{
+			name:     "CORD",
+			prefix:   29,
+			network:  "cord",
+			symbols:  []string{"DHI", "WAY"},
+			decimals: []int{12, 12},
+			account:  AccountAny25519,
+			valid:    true,
+		},
+		{
+			name:   "Unknown",
+			prefix: 16000,
+			valid:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			net, err := ByPrefix(tt.prefix)
+
+			if !tt.valid {
+				if err != ErrUnknownPrefix {
+					t.Errorf("Expected unknown prefix error, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Error looking up prefix: %v", err)
+			}
+
+			if net.Name() != tt.network {
+				t.Errorf("Wrong network name, expected %v, got %v", tt.network, net.Name())
+			}
+
+			if !reflect.DeepEqual(net.Symbols, tt.symbols) {
+				t.Errorf("Wrong symbols, expected %v, got %v", tt.symbols, net.Symbols)
+			}
+
+			if !reflect.DeepEqual(net.Decimals, tt.decimals) {
+				t.Errorf("Wrong decimals, expected %v, got %v", tt.decimals, net.Decimals)
+			}
+
+			if net.StandardAccount != tt.account {
+				t.Errorf("Wrong account type, expected %v, got %v", tt.account, net.StandardAccount)
+			}
+		})
+	}
+}
+
+func TestByName(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		network string
+		ss58    string
+		valid   bool
+	}{
+		{
+			name:    "Polkadot",
+			network: "polkadot",
+			ss58:    "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5",
+			valid:   true,
+		},
+		{
+			name:    "Kusama",
+			network: "kusama",
+			ss58:    "HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F",
+			valid:   true,
+		},
+		{
+			name:    "Substrate",
+			network: "substrate",
+			ss58:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+			valid:   true,
+		},
+		{
+			name:    "Unique",
+			network: "unique_mainnet",
+			ss58:    "unjKJQJrRd238pkUZZvzDQrfKuM39zBSnQ5zjAGAGcdRhaJTx",
+			valid:   true,
+		},
+		{
+			name:    "Basilisk",
+			network: "basilisk",
+			ss58:    "bXmPf7DcVmFuHEmzH3UX8t6AUkfNQW8pnTeXGhFhqbfngjAak",
+			valid:   true,
+		},
+		{
+			name:    "Frequency",
+			network: "frequency",
+			ss58:    "f6cL4wq1HUNx11TcvdABNf9UNXXoyH47mVUwT59tzSFRW8yDH",
+			valid:   true,
+		},
+		{
+			name:    "Vara",
+			network: "vara",
+			ss58:    "kGkLEU3e3XXkJp2WK4eNpVmSab5xUNL9QtmLPh8QfCL2EgotW",
+			valid:   true,
+		},
+		{
+			name:    "Peaq",
+			network: "peaq",
+			ss58:    "rtJTr5eursuiFBfbWhE281jk2xHeoLpaBzNs6eWa33FicWoJ9",
+			valid:   true,
+		},
+		{
+			name:    "Unknown",
+			network: "unknown",
+			valid:   false,
+		},
+	}
+
+	// alice is the public key of the development account //Alice
+	alice := [32]byte{0xd4, 0x35, 0x93, 0xc7, 0x15, 0xfd, 0xd3, 0x1c, 0x61, 0x14, 0x1a, 0xbd, 0x04, 0xa9, 0x9f, 0xd6, 0x82, 0x2c, 0x85, 0x58, 0x85, 0x4c, 0xcd, 0xe3, 0x9a, 0x56, 0x84, 0xe7, 0xa5, 0x6d, 0xa2, 0x7d}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			net, err := ByName(tt.network)
+
+			if !tt.valid {
+				if err != ErrUnknownNetwork {
+					t.Errorf("Expected unknown network error, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Error looking up network: %v", err)
+			}
+
+			ss58, err := srkeyring.SS58Address(alice, net, srkeyring.SS58Checksum)
+
+			if err != nil {
+				t.Fatalf("Error getting SS58 Address: %v", err)
+			}
+
+			if ss58 != tt.ss58 {
+				t.Errorf("Invalid SS58 Address, expected %v, got %v", tt.ss58, ss58)
+			}
+		})
+	}
+}
+
+func TestByNameFold(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		network string
+		expect  string
+		err     error
+	}{
+		{
+			name:    "Lower Case",
+			network: "polkadot",
+			expect:  "polkadot",
+		},
+		{
+			name:    "Upper Case Input",
+			network: "POLKADOT",
+			expect:  "polkadot",
+		},
+		{
+			name:    "Upper Case Name",
+			network: "kico",
+			expect:  "KICO",
+		},
+		{
+			name:    "Mixed Case Name",
+			network: "baresr25519",
+			expect:  "BareSr25519",
+		},
+		{
+			name:    "Exact Mixed Case Name",
+			network: "BareSr25519",
+			expect:  "BareSr25519",
+		},
+		{
+			name:    "Unknown",
+			network: "unknown",
+			err:     ErrUnknownNetwork,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			net, err := ByNameFold(tt.network)
+
+			if err != tt.err {
+				t.Fatalf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+
+			if tt.err != nil {
+				return
+			}
+
+			if net.Name() != tt.expect {
+				t.Errorf("Invalid network, expected %v, got %v", tt.expect, net.Name())
+			}
+		})
+	}
+
+	// names differing only by case match exactly when possible
+	r, err := New([]*Network{
+		{Network: "test", SS58Prefix: 1000},
+		{Network: "TEST", SS58Prefix: 1001},
+	})
+
+	if err != nil {
+		t.Fatalf("Error creating registry: %v", err)
+	}
+
+	if net, err := r.ByNameFold("TEST"); err != nil || net.Prefix() != 1001 {
+		t.Errorf("Expected exact match of TEST, got %v %v", net, err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		json  string
+		valid bool
+	}{
+		{
+			name: "Valid",
+			json: `{"registry": [
+				{"prefix": 0, "network": "polkadot", "displayName": "Polkadot Relay Chain", "symbols": ["DOT"], "decimals": [10], "standardAccount": "*25519", "website": "https://polkadot.network"},
+				{"prefix": 16000, "network": "newchain", "displayName": "New Chain", "symbols": ["NEW"], "decimals": [12], "standardAccount": "*25519", "website": null}
+			]}`,
+			valid: true,
+		},
+		{
+			name: "Duplicate Prefix",
+			json: `{"registry": [
+				{"prefix": 0, "network": "polkadot", "symbols": [], "decimals": []},
+				{"prefix": 0, "network": "other", "symbols": [], "decimals": []}
+			]}`,
+			valid: false,
+		},
+		{
+			name: "Duplicate Name",
+			json: `{"registry": [
+				{"prefix": 0, "network": "polkadot", "symbols": [], "decimals": []},
+				{"prefix": 1, "network": "polkadot", "symbols": [], "decimals": []}
+			]}`,
+			valid: false,
+		},
+		{
+			name: "Prefix Out of Range",
+			json: `{"registry": [
+				{"prefix": 16384, "network": "toolarge", "symbols": [], "decimals": []}
+			]}`,
+			valid: false,
+		},
+		{
+			name:  "Invalid JSON",
+			json:  `{"registry": [`,
+			valid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reg, err := Load(strings.NewReader(tt.json))
+
+			if !tt.valid {
+				if err == nil {
+					t.Errorf("Expected error loading registry")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Error loading registry: %v", err)
+			}
+
+			net, err := reg.ByPrefix(16000)
+
+			if err != nil {
+				t.Fatalf("Error looking up prefix: %v", err)
+			}
+
+			if net.Name() != "newchain" {
+				t.Errorf("Wrong network name, expected newchain, got %v", net.Name())
+			}
+		})
+	}
+}
+
+func TestSetDefault(t *testing.T) {
+
+	orig := Default()
+	defer SetDefault(orig)
+
+	reg, err := New([]*Network{
+		{SS58Prefix: 16000, Network: "newchain"},
+	})
+
+	if err != nil {
+		t.Fatalf("Error creating registry: %v", err)
+	}
+
+	SetDefault(reg)
+
+	if _, err := ByName("newchain"); err != nil {
+		t.Errorf("Error looking up network in new default registry: %v", err)
+	}
+
+	if _, err := ByName("polkadot"); err != ErrUnknownNetwork {
+		t.Errorf("Expected unknown network error, got %v", err)
+	}
+}
+
+// TestGenerated checks the generated networks are up to date with the
+// bundled ss58-registry.json file
+func TestGenerated(t *testing.T) {
+
+	reg, err := LoadFile("ss58-registry.json")
+
+	if err != nil {
+		t.Fatalf("Error loading registry file: %v", err)
+	}
+
+	if !reflect.DeepEqual(reg.Networks(), networks) {
+		t.Errorf("Generated networks do not match ss58-registry.json, run go generate")
+	}
+}
@@ -0,0 +1,184 @@
+package srkeyring
+
+import (
+	"errors"
+)
+
+// sessionWordCount is the number of mnemonic words used when generating a
+// session key bundle
+const sessionWordCount = 12
+
+var (
+	// SubstrateSessionKeyTypes is the SessionKeys order of the Substrate
+	// node runtime
+	SubstrateSessionKeyTypes = []SessionKeyType{
+		DefaultSessionKeyType(KeyTypeGrandpa),
+		DefaultSessionKeyType(KeyTypeBabe),
+		DefaultSessionKeyType(KeyTypeImOnline),
+		DefaultSessionKeyType(KeyTypeAuthorityDiscovery),
+	}
+
+	ErrNoSessionKeyTypes = errors.New("No session key types given")
+)
+
+// SessionKeyType defines a key in a runtime's SessionKeys, the scheme it uses,
+// and the junction it is derived with from the bundle's Secret URI
+type SessionKeyType struct {
+	// KeyType is the key type id
+	KeyType KeyTypeID
+	// Scheme is the scheme of the key
+	Scheme Scheme
+	// Junction is the derivation path appended to the bundle's Secret URI,
+	// eg: "//babe".  An empty Junction uses the Secret URI as is
+	Junction string
+}
+
+// DefaultSessionKeyType returns the SessionKeyType of the key type using the
+// scheme Substrate uses for it and a hard junction of the key type name.
+// Key types with no default scheme use Sr25519
+func DefaultSessionKeyType(kt KeyTypeID) SessionKeyType {
+
+	scheme, err := kt.Scheme()
+
+	if err != nil {
+		scheme = Sr25519
+	}
+
+	return SessionKeyType{
+		KeyType:  kt,
+		Scheme:   scheme,
+		Junction: "//" + kt.String(),
+	}
+}
+
+// SessionKey is a key of a session key bundle
+type SessionKey struct {
+	SessionKeyType
+	// SURI is the Secret URI the key was derived from
+	SURI string
+	// KeyPair is the key pair
+	KeyPair KeyPair
+}
+
+// SessionKeys is a bundle of session keys in the order of a runtime's
+// SessionKeys
+type SessionKeys struct {
+	// Keys are the session keys
+	Keys []SessionKey
+	// suri is the Secret URI the bundle was derived from
+	suri string
+}
+
+// GenerateSessionKeys creates a session key bundle derived from a randomly
+// created mnemonic
+func GenerateSessionKeys(types []SessionKeyType, net Network) (*SessionKeys, error) {
+
+	kr, err := Generate(sessionWordCount, net)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonic, err := kr.Mnemonic()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return DeriveSessionKeys(mnemonic, types, net)
+}
+
+// DeriveSessionKeys returns the session key bundle derived from the Secret URI
+// using each key type's junction and scheme
+func DeriveSessionKeys(str string, types []SessionKeyType, net Network) (*SessionKeys, error) {
+
+	if len(types) == 0 {
+		return nil, ErrNoSessionKeyTypes
+	}
+
+	suri, err := NewSecretURI(str, net)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sk := &SessionKeys{
+		Keys: make([]SessionKey, 0, len(types)),
+		suri: str,
+	}
+
+	for _, t := range types {
+
+		keySURI, err := deriveURI(suri, "", t.Junction)
+
+		if err != nil {
+			return nil, err
+		}
+
+		kp, err := FromURIWithScheme(keySURI, net, t.Scheme)
+
+		if err != nil {
+			return nil, err
+		}
+
+		sk.Keys = append(sk.Keys, SessionKey{
+			SessionKeyType: t,
+			SURI:           keySURI,
+			KeyPair:        kp,
+		})
+	}
+
+	return sk, nil
+}
+
+// SURI returns the Secret URI the bundle was derived from
+func (s *SessionKeys) SURI() string {
+	return s.suri
+}
+
+// Encode returns the SCALE encoded SessionKeys, which is the concatenation of
+// each public key in order
+func (s *SessionKeys) Encode() []byte {
+	var buf []byte
+
+	for _, key := range s.Keys {
+		buf = append(buf, key.KeyPair.PublicKey()...)
+	}
+
+	return buf
+}
+
+// Hex returns the SCALE encoded SessionKeys hex encoded as returned by the
+// author_rotateKeys RPC
+func (s *SessionKeys) Hex() string {
+	return EncodeHex(s.Encode(), "0x")
+}
+
+// SetKeysParams returns the SCALE encoded parameters (keys, proof) of the
+// session.setKeys call
+func (s *SessionKeys) SetKeysParams(proof []byte) ([]byte, error) {
+
+	cl, err := compactUint(uint64(len(proof)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := s.Encode()
+	buf = append(buf, cl...)
+	buf = append(buf, proof...)
+
+	return buf, nil
+}
+
+// InsertSessionKeys stores each key of the session key bundle in the keystore
+func (ks *Keystore) InsertSessionKeys(s *SessionKeys) error {
+
+	for _, key := range s.Keys {
+		if _, err := ks.InsertWithScheme(key.KeyType, key.SURI, key.Scheme); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package srkeyring
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// alice public keys of each scheme derived from devPhrase//Alice
+const (
+	aliceSr25519 = "d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"
+	aliceEd25519 = "88dc3417d5058ec4b4503e0c12ea1a0a89be200fe98922423d4334014fa6b0ee"
+	aliceEcdsa   = "020a1091341fe5664bfa1782d5e04779689068c916b04cb365ec3153755684d9a1"
+)
+
+// aliceSessionKeyType returns the default SessionKeyType with no junction so
+// the dev Alice keys are used
+func aliceSessionKeyType(kt KeyTypeID) SessionKeyType {
+	t := DefaultSessionKeyType(kt)
+	t.Junction = ""
+	return t
+}
+
+func TestDeriveSessionKeys(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		suri  string
+		types []SessionKeyType
+		keys  []string
+		suris []string
+	}{
+		{
+			name: "Substrate Alice",
+			suri: devPhrase + "//Alice",
+			types: []SessionKeyType{
+				aliceSessionKeyType(KeyTypeGrandpa),
+				aliceSessionKeyType(KeyTypeBabe),
+				aliceSessionKeyType(KeyTypeImOnline),
+				aliceSessionKeyType(KeyTypeAuthorityDiscovery),
+			},
+			keys: []string{aliceEd25519, aliceSr25519, aliceSr25519, aliceSr25519},
+		},
+		{
+			name: "Relay Chain Alice",
+			suri: devPhrase + "//Alice",
+			types: []SessionKeyType{
+				aliceSessionKeyType(KeyTypeGrandpa),
+				aliceSessionKeyType(KeyTypeBabe),
+				aliceSessionKeyType(KeyTypeImOnline),
+				aliceSessionKeyType(KeyTypeParachain),
+				aliceSessionKeyType(KeyTypeAssignment),
+				aliceSessionKeyType(KeyTypeAuthorityDiscovery),
+				aliceSessionKeyType(KeyTypeBeefy),
+			},
+			keys: []string{aliceEd25519, aliceSr25519, aliceSr25519, aliceSr25519,
+				aliceSr25519, aliceSr25519, aliceEcdsa},
+		},
+		{
+			name: "Junctions with Password",
+			suri: devPhrase + "///pass1234",
+			types: []SessionKeyType{
+				{KeyType: KeyTypeGrandpa, Scheme: Ed25519, Junction: "//gran"},
+				{KeyType: KeyTypeBabe, Scheme: Sr25519, Junction: "//babe/0"},
+			},
+			suris: []string{
+				devPhrase + "//gran///pass1234",
+				devPhrase + "//babe/0///pass1234",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sk, err := DeriveSessionKeys(tt.suri, tt.types, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error deriving session keys: %v", err)
+			}
+
+			if len(sk.Keys) != len(tt.types) {
+				t.Fatalf("Invalid key count, expected %d, got %d", len(tt.types), len(sk.Keys))
+			}
+
+			var expected string
+
+			for i, key := range sk.Keys {
+
+				if key.KeyType != tt.types[i].KeyType || key.KeyPair.Scheme() != tt.types[i].Scheme {
+					t.Errorf("Invalid key %d type, expected %v %v, got %v %v", i,
+						tt.types[i].KeyType, tt.types[i].Scheme, key.KeyType, key.KeyPair.Scheme())
+				}
+
+				if tt.keys != nil {
+					pub := hex.EncodeToString(key.KeyPair.PublicKey())
+
+					if pub != tt.keys[i] {
+						t.Errorf("Invalid key %d, expected %v, got %v", i, tt.keys[i], pub)
+					}
+				}
+
+				if tt.suris != nil {
+					if key.SURI != tt.suris[i] {
+						t.Errorf("Invalid key %d SURI, expected %v, got %v", i, tt.suris[i], key.SURI)
+					}
+
+					kp, err := FromURIWithScheme(tt.suris[i], NetSubstrate{}, tt.types[i].Scheme)
+
+					if err != nil {
+						t.Fatalf("Error generating key pair: %v", err)
+					}
+
+					if kp.AccountID() != key.KeyPair.AccountID() {
+						t.Errorf("Invalid key %d, expected %x, got %x", i, kp.AccountID(), key.KeyPair.AccountID())
+					}
+				}
+
+				expected += hex.EncodeToString(key.KeyPair.PublicKey())
+			}
+
+			if sk.Hex() != "0x"+expected {
+				t.Errorf("Invalid session keys, expected 0x%v, got %v", expected, sk.Hex())
+			}
+
+			params, err := sk.SetKeysParams(nil)
+
+			if err != nil {
+				t.Fatalf("Error encoding setKeys params: %v", err)
+			}
+
+			if hex.EncodeToString(params) != expected+"00" {
+				t.Errorf("Invalid setKeys params, expected %v00, got %x", expected, params)
+			}
+		})
+	}
+}
+
+func TestDeriveSessionKeysInvalid(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		suri  string
+		types []SessionKeyType
+		err   error
+	}{
+		{
+			name:  "No Types",
+			suri:  devPhrase,
+			types: nil,
+			err:   ErrNoSessionKeyTypes,
+		},
+		{
+			name: "Soft Junction Ed25519",
+			suri: devPhrase,
+			types: []SessionKeyType{
+				{KeyType: KeyTypeGrandpa, Scheme: Ed25519, Junction: "/gran"},
+			},
+			err: ErrSoftDerivation,
+		},
+		{
+			name: "Invalid Junction",
+			suri: devPhrase,
+			types: []SessionKeyType{
+				{KeyType: KeyTypeBabe, Scheme: Sr25519, Junction: "babe"},
+			},
+			err: ErrInvalidDerivePath,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := DeriveSessionKeys(tt.suri, tt.types, NetSubstrate{})
+
+			if err != tt.err {
+				t.Errorf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestGenerateSessionKeys(t *testing.T) {
+
+	sk, err := GenerateSessionKeys(SubstrateSessionKeyTypes, NetSubstrate{})
+
+	if err != nil {
+		t.Fatalf("Error generating session keys: %v", err)
+	}
+
+	// the bundle is reproducible from its Secret URI
+	sk2, err := DeriveSessionKeys(sk.SURI(), SubstrateSessionKeyTypes, NetSubstrate{})
+
+	if err != nil {
+		t.Fatalf("Error deriving session keys: %v", err)
+	}
+
+	if sk.Hex() != sk2.Hex() {
+		t.Errorf("Invalid session keys, expected %v, got %v", sk.Hex(), sk2.Hex())
+	}
+
+	// grandpa, babe, im_online, authority_discovery public keys
+	if len(sk.Encode()) != 4*32 {
+		t.Errorf("Invalid session keys length, got %d", len(sk.Encode()))
+	}
+
+	ks := tempKeystore(t)
+
+	if err := ks.InsertSessionKeys(sk); err != nil {
+		t.Fatalf("Error inserting session keys: %v", err)
+	}
+
+	for _, key := range sk.Keys {
+		kp, err := ks.Load(key.KeyType, key.KeyPair.PublicKey())
+
+		if err != nil {
+			t.Fatalf("Error loading key %v: %v", key.KeyType, err)
+		}
+
+		if kp.AccountID() != key.KeyPair.AccountID() {
+			t.Errorf("Invalid key %v, expected %x, got %x", key.KeyType, key.KeyPair.AccountID(), kp.AccountID())
+		}
+	}
+}
@@ -20,19 +20,89 @@ const (
 	SS58Checksum ChecksumType = iota
 	// AccountID uses the address as the blake2b hash pre-image
 	AccountID
+
+	// maxSimplePrefix is the highest network prefix that can be encoded in a
+	// single byte
+	maxSimplePrefix = 63
+
+	// maxFullPrefix is the highest network prefix that can be encoded using
+	// the two byte format
+	maxFullPrefix = 16383
 )
 
+// encodeSS58Prefix encodes the network prefix into its one or two byte SS58
+// representation.
+// See https://github.com/paritytech/substrate/blob/master/primitives/core/src/crypto.rs
+// function to_ss58check_with_version()
+func encodeSS58Prefix(prefix uint16) ([]byte, error) {
+
+	switch {
+	case prefix <= maxSimplePrefix:
+		return []byte{byte(prefix)}, nil
+
+	case prefix <= maxFullPrefix:
+		// upper six bits of the lower byte are stored in the first byte with
+		// the 0b01 marker set, the remaining bits are stored in the second byte
+		first := byte((prefix&0x00fc)>>2) | 0x40
+		second := byte(prefix>>8) | byte((prefix&0x0003)<<6)
+		return []byte{first, second}, nil
+
+	default:
+		return nil, fmt.Errorf("invalid network prefix: %v", prefix)
+	}
+}
+
+// decodeSS58Prefix decodes the one or two byte SS58 network prefix from the
+// start of the given data and returns the prefix and number of bytes it
+// occupied
+func decodeSS58Prefix(data []byte) (uint16, int, error) {
+
+	if len(data) == 0 {
+		return 0, 0, fmt.Errorf("invalid string, too short")
+	}
+
+	switch {
+	case data[0] <= maxSimplePrefix:
+		return uint16(data[0]), 1, nil
+
+	case data[0] < 0x80:
+		if len(data) < 2 {
+			return 0, 0, fmt.Errorf("invalid string, too short")
+		}
+
+		lower := (data[0] << 2) | (data[1] >> 6)
+		upper := data[1] & 0x3f
+
+		return uint16(lower) | uint16(upper)<<8, 2, nil
+
+	default:
+		return 0, 0, fmt.Errorf("invalid network prefix on decode")
+	}
+}
+
 // SS58Address derives ss58 address from the address, network, and checksumType
 func SS58Address(addr [32]byte, net Network, ctype ChecksumType) (string, error) {
+	return ss58Encode(addr[:], net, ctype)
+}
+
+// ss58Encode encodes the raw bytes, such as an account id or public key, as
+// ss58 for the network and checksumType
+func ss58Encode(addr []byte, net Network, ctype ChecksumType) (string, error) {
+
+	prefix, err := encodeSS58Prefix(NetworkPrefix(net))
+
+	if err != nil {
+		return "", err
+	}
 
 	var cbuf []byte
 
 	switch ctype {
 	case SS58Checksum:
-		cbuf = append([]byte{net.Version()}, addr[:]...)
+		cbuf = append(append([]byte{}, prefix...), addr...)
 
 	case AccountID:
-		cbuf = addr[:]
+		cbuf = addr
 
 	default:
 		return "", fmt.Errorf("unknown checksum type: %v", ctype)
@@ -44,7 +114,7 @@ func SS58Address(addr [32]byte, net Network, ctype ChecksumType) (string, error)
 		return "", err
 	}
 
-	fb := append([]byte{net.Version()}, addr[:]...)
+	fb := append(append([]byte{}, prefix...), addr...)
 	fb = append(fb, cs[net.ChecksumStart():net.ChecksumEnd()]...)
 
 	return base58.Encode(fb), nil
@@ -81,8 +151,12 @@ func DecodeSS58Address(addr string, net Network, ctype ChecksumType) ([32]byte,
 	var rawAddr [32]byte
 
 	// validate network version
-	version := net.Version()
-	versionBytes := []byte{version}
+	versionBytes, err := encodeSS58Prefix(NetworkPrefix(net))
+
+	if err != nil {
+		return rawAddr, err
+	}
+
 	versionLen := len(versionBytes)
 
 	//decode address from base58 to raw bytes
@@ -107,7 +181,7 @@ func DecodeSS58Address(addr string, net Network, ctype ChecksumType) ([32]byte,
 
 	switch ctype {
 	case SS58Checksum:
-		cbuf = append([]byte{version}, bufAddr[:]...)
+		cbuf = append(append([]byte{}, versionBytes...), bufAddr[:]...)
 	case AccountID:
 		cbuf = bufAddr[:]
 	default:
@@ -131,3 +205,71 @@ func DecodeSS58Address(addr string, net Network, ctype ChecksumType) ([32]byte,
 
 	return rawAddr, nil
 }
+
+// DecodeAnySS58Address takes a string and checks if it is a validly encoded
+// SS58 address for any network prefix using the standard two byte checksum.
+// It returns the raw address in bytes and the network prefix the address was
+// encoded with
+func DecodeAnySS58Address(addr string, ctype ChecksumType) ([32]byte, uint16, error) {
+	var rawAddr [32]byte
+
+	//decode address from base58 to raw bytes
+	dec := base58.Decode(addr)
+
+	prefix, prefixLen, err := decodeSS58Prefix(dec)
+
+	if err != nil {
+		return rawAddr, 0, err
+	}
+
+	// check that the decoded bytes length is the length of the network prefix
+	// plus the 32 bytes for the raw address and two byte checksum
+	if len(dec) != (prefixLen + 32 + 2) {
+		return rawAddr, 0, fmt.Errorf("invalid string length")
+	}
+
+	// get the raw bytes address and remaining bytes as checksum
+	bufAddr := dec[prefixLen:(32 + prefixLen)]
+	checksum := dec[(32 + prefixLen):]
+
+	var cbuf []byte
+
+	switch ctype {
+	case SS58Checksum:
+		cbuf = dec[:(32 + prefixLen)]
+	case AccountID:
+		cbuf = bufAddr[:]
+	default:
+		return rawAddr, 0, fmt.Errorf("unknown checksum type: %v", ctype)
+	}
+
+	// generate the expected checksum from raw address
+	cs, err := ss58Checksum(cbuf)
+
+	if err != nil {
+		return rawAddr, 0, err
+	}
+
+	// compare checksums
+	if !bytes.Equal(checksum, cs[0:2]) {
+		return rawAddr, 0, fmt.Errorf("invalid checksum comparison")
+	}
+
+	// copy and return valid raw address
+	copy(rawAddr[:], bufAddr[:32])
+
+	return rawAddr, prefix, nil
+}
+
+// ReencodeSS58Address takes a SS58 address encoded for the "from" Network and
+// returns the same address encoded for the "to" Network
+func ReencodeSS58Address(addr string, from, to Network, ctype ChecksumType) (string, error) {
+
+	raw, err := DecodeSS58Address(addr, from, ctype)
+
+	if err != nil {
+		return "", err
+	}
+
+	return SS58Address(raw, to, ctype)
+}
@@ -1,10 +1,23 @@
 package srkeyring
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
 )
 
+// netWide implements the WideNetwork interface for testing SS58 addresses
+// with network prefixes requiring two byte encoding
+type netWide struct {
+	NetSubstrate
+	prefix uint16
+}
+
+// Prefix returns the network prefix used in SS58 address formatting
+func (n netWide) Prefix() uint16 {
+	return n.prefix
+}
+
 var ss58tests = []struct {
 	name  string
 	addr  string
@@ -26,6 +39,69 @@ var ss58tests = []struct {
 		ctype: SS58Checksum,
 		ss58:  "5HWGdRwMFAfm89MChot9sdfaSkpJciStmD4CMfqQHCNLHQqV",
 	},
+	{
+		name:  "Alice Polkadot",
+		addr:  "0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+		net:   netWide{prefix: 0},
+		ctype: SS58Checksum,
+		ss58:  "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5",
+	},
+	{
+		name:  "Alice Max Single Byte Prefix",
+		addr:  "0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+		net:   netWide{prefix: 63},
+		ctype: SS58Checksum,
+		ss58:  "7NPoMQbiA6trJKkjB35uk96MeJD4PGWkLQLH7k7hXEkZpiba",
+	},
+	{
+		name:  "Alice Min Two Byte Prefix",
+		addr:  "0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+		net:   netWide{prefix: 64},
+		ctype: SS58Checksum,
+		ss58:  "cEaNSpz4PxFcZ7nT1VEKrKewH67rfx6MfcM6yKojyyPz7qaqp",
+	},
+	{
+		name:  "Alice Crust",
+		addr:  "0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+		net:   netWide{prefix: 66},
+		ctype: SS58Checksum,
+		ss58:  "cTM8suyN19VZb7JEPRNvtezyfpEAJyYxHkk1n5J4XEr6XroRa",
+	},
+	{
+		name:  "Alice Quartz",
+		addr:  "0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+		net:   netWide{prefix: 255},
+		ctype: SS58Checksum,
+		ss58:  "yGHXkYLYqxijLKKfd9Q2CB9shRVu8rPNBS53wvwGTutYg4zTg",
+	},
+	{
+		name:  "Alice Interlay",
+		addr:  "0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+		net:   netWide{prefix: 2032},
+		ctype: SS58Checksum,
+		ss58:  "wdCJ8CsZchTEfUP8Xz1eZKNRjW5cuYjJ9fh6pcZNXezsysBrJ",
+	},
+	{
+		name:  "Alice Unique",
+		addr:  "0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+		net:   netWide{prefix: 7391},
+		ctype: SS58Checksum,
+		ss58:  "unjKJQJrRd238pkUZZvzDQrfKuM39zBSnQ5zjAGAGcdRhaJTx",
+	},
+	{
+		name:  "Alice Basilisk",
+		addr:  "0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+		net:   netWide{prefix: 10041},
+		ctype: SS58Checksum,
+		ss58:  "bXmPf7DcVmFuHEmzH3UX8t6AUkfNQW8pnTeXGhFhqbfngjAak",
+	},
+	{
+		name:  "Alice Max Two Byte Prefix",
+		addr:  "0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+		net:   netWide{prefix: 16383},
+		ctype: SS58Checksum,
+		ss58:  "yNa8JpqfFB3q8A29rCwSgxvdU94ufJw2yKKxDgznS5m1PoFvn",
+	},
 }
 
 func TestSS58Address(t *testing.T) {
@@ -86,3 +162,231 @@ func TestDecodeSS58Address(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeSS58AddressWrongNetwork(t *testing.T) {
+
+	tests := []struct {
+		name string
+		ss58 string
+		net  Network
+	}{
+		{
+			name: "Single Byte Prefix",
+			ss58: "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5",
+			net:  NetSubstrate{},
+		},
+		{
+			name: "Two Byte Prefix",
+			ss58: "unjKJQJrRd238pkUZZvzDQrfKuM39zBSnQ5zjAGAGcdRhaJTx",
+			net:  netWide{prefix: 10041},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := DecodeSS58Address(tt.ss58, tt.net, SS58Checksum)
+
+			if err == nil {
+				t.Errorf("Expected error decoding SS58 Address with wrong network")
+			}
+		})
+	}
+}
+
+func TestSS58Prefix(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		prefix uint16
+		bytes  []byte
+		valid  bool
+	}{
+		{
+			name:   "Zero",
+			prefix: 0,
+			bytes:  []byte{0x00},
+			valid:  true,
+		},
+		{
+			name:   "Max Single Byte",
+			prefix: 63,
+			bytes:  []byte{0x3f},
+			valid:  true,
+		},
+		{
+			name:   "Min Two Byte",
+			prefix: 64,
+			bytes:  []byte{0x50, 0x00},
+			valid:  true,
+		},
+		{
+			name:   "Two Byte 255",
+			prefix: 255,
+			bytes:  []byte{0x7f, 0xc0},
+			valid:  true,
+		},
+		{
+			name:   "Max Two Byte",
+			prefix: 16383,
+			bytes:  []byte{0x7f, 0xff},
+			valid:  true,
+		},
+		{
+			name:   "Out of Range",
+			prefix: 16384,
+			valid:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := encodeSS58Prefix(tt.prefix)
+
+			if !tt.valid {
+				if err == nil {
+					t.Errorf("Expected error encoding prefix %v", tt.prefix)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Error encoding prefix: %v", err)
+			}
+
+			if !bytes.Equal(res, tt.bytes) {
+				t.Errorf("Invalid prefix encoding, expected %v, got %v", tt.bytes, res)
+			}
+
+			prefix, n, err := decodeSS58Prefix(res)
+
+			if err != nil {
+				t.Fatalf("Error decoding prefix: %v", err)
+			}
+
+			if prefix != tt.prefix || n != len(tt.bytes) {
+				t.Errorf("Invalid prefix decode, expected %v, got %v", tt.prefix, prefix)
+			}
+		})
+	}
+}
+
+func TestDecodeAnySS58Address(t *testing.T) {
+
+	for _, tt := range ss58tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// convert address into bytes
+			rawAddr, ok := DecodeHex(tt.addr, tt.net.AddressPrefix())
+
+			if !ok {
+				t.Fatalf("Invalid hex encoded address: %v", tt.addr)
+			}
+
+			var expectedB [32]byte
+			copy(expectedB[:], rawAddr)
+
+			res, prefix, err := DecodeAnySS58Address(tt.ss58, tt.ctype)
+
+			if err != nil {
+				t.Fatalf("Error decoding SS58 Address: %v", err)
+			}
+
+			if !reflect.DeepEqual(res, expectedB) {
+				t.Errorf("Invalid SS58 Address decode, expected %v, got %v", expectedB, res)
+			}
+
+			if prefix != NetworkPrefix(tt.net) {
+				t.Errorf("Invalid network prefix, expected %v, got %v", NetworkPrefix(tt.net), prefix)
+			}
+		})
+	}
+}
+
+func TestDecodeAnySS58AddressInvalid(t *testing.T) {
+
+	tests := []struct {
+		name string
+		ss58 string
+	}{
+		{
+			name: "Bad Checksum",
+			ss58: "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQZ",
+		},
+		{
+			name: "Too Short",
+			ss58: "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGK",
+		},
+		{
+			name: "Empty",
+			ss58: "",
+		},
+		{
+			name: "Reserved Prefix Byte",
+			ss58: "DmmS5zJcCEx2zWnNw9c3LM3Pj7Je2Tbd9PuokVKYGT7R2Mfm",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := DecodeAnySS58Address(tt.ss58, SS58Checksum)
+
+			if err == nil {
+				t.Errorf("Expected error decoding invalid SS58 Address")
+			}
+		})
+	}
+}
+
+func TestReencodeSS58Address(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		ss58     string
+		from     Network
+		to       Network
+		expected string
+	}{
+		{
+			name:     "Substrate to Polkadot",
+			ss58:     "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+			from:     NetSubstrate{},
+			to:       netWide{prefix: 0},
+			expected: "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5",
+		},
+		{
+			name:     "Polkadot to Unique",
+			ss58:     "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5",
+			from:     netWide{prefix: 0},
+			to:       netWide{prefix: 7391},
+			expected: "unjKJQJrRd238pkUZZvzDQrfKuM39zBSnQ5zjAGAGcdRhaJTx",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := ReencodeSS58Address(tt.ss58, tt.from, tt.to, SS58Checksum)
+
+			if err != nil {
+				t.Fatalf("Error re-encoding SS58 Address: %v", err)
+			}
+
+			if res != tt.expected {
+				t.Errorf("Invalid SS58 Address, expected %v, got %v", tt.expected, res)
+			}
+		})
+	}
+}
@@ -3,7 +3,9 @@ package srkeyring
 import (
 	"errors"
 	sr25519 "github.com/ChainSafe/go-schnorrkel"
+	"golang.org/x/text/unicode/norm"
 	"regexp"
+	"strings"
 )
 
 const (
@@ -17,8 +19,9 @@ const (
 
 var (
 	// suriRe is the regular expression for matching the Secret URI of format
-	// <phrase><path>///<password>
-	suriRe = regexp.MustCompile(`^(?P<phrase>[\d\w ]+)?(?P<path>(//?[^/]+)*)(///(?P<password>.*))?$`)
+	// <phrase><path>///<password>.  The phrase and junctions may contain any
+	// UTF-8 characters other than "/"
+	suriRe = regexp.MustCompile(`^(?P<phrase>[^/]+)?(?P<path>(//?[^/]+)*)(///(?P<password>.*))?$`)
 
 	// pathRe is the regular expression for matching the parts of the path
 	// component of the Secret URI
@@ -28,8 +31,13 @@ var (
 	ErrInvalidSURIFormat = errors.New("Secret URI format is invalid")
 	ErrEmptyPhrase       = errors.New("The parsed Secret URI has an empty phrase")
 	ErrInvalidByteLength = errors.New("An invalid number of bytes has been given")
+	ErrJunctionNotPath   = errors.New("Junction can not be represented in a Secret URI path")
 )
 
+// redacted is the text secret Secret URI components are replaced with by
+// Redacted()
+const redacted = "***"
+
 // PhraseType specifies the type of data that was passed as the phrase component
 // in a Secret URI
 type PhraseType int
@@ -39,6 +47,7 @@ const (
 	SS58Public
 	Mnemonic
 	RawPublicKey
+	RawSecretKey
 )
 
 // SecretURI defines a struct consisting of the parts of a Secret URI
@@ -80,6 +89,158 @@ func NewSecretURI(suri string, net Network) (*SecretURI, error) {
 	return data, nil
 }
 
+// String returns the Secret URI in the canonical format
+// <phrase><path>///<password>, with the password separator omitted when no
+// password is set
+func (s *SecretURI) String() string {
+
+	str := s.Phrase + s.Path
+
+	if s.Password != "" {
+		str += "///" + s.Password
+	}
+
+	return str
+}
+
+// Redacted returns the Secret URI with the phrase and password masked so it
+// can be logged safely.  The derivation path and a phrase which is an SS58
+// public address are not secret so are kept
+func (s *SecretURI) Redacted() string {
+
+	str := redacted
+
+	if s.Phrase == "" {
+		str = ""
+	} else if s.Network != nil {
+		if _, err := DecodeSS58Address(s.Phrase, s.Network, SS58Checksum); err == nil {
+			str = s.Phrase
+		}
+	}
+
+	str += s.Path
+
+	if s.Password != "" {
+		str += "///" + redacted
+	}
+
+	return str
+}
+
+// SecretURIBuilder assembles a SecretURI from its phrase, junctions and
+// password
+type SecretURIBuilder struct {
+	phrase   string
+	path     DerivationPath
+	password string
+	net      Network
+	err      error
+}
+
+// NewSecretURIBuilder returns a SecretURIBuilder for the given phrase, which
+// is a mnemonic, hex encoded secret, or SS58 address
+func NewSecretURIBuilder(phrase string, net Network) *SecretURIBuilder {
+	return &SecretURIBuilder{
+		phrase: phrase,
+		net:    net,
+	}
+}
+
+// Junction appends the junctions to the derivation path
+func (b *SecretURIBuilder) Junction(j ...*Junction) *SecretURIBuilder {
+	b.path = append(b.path, j...)
+	return b
+}
+
+// Path appends the junctions of the parsed derivation path, eg: "//polkadot/0"
+func (b *SecretURIBuilder) Path(path string) *SecretURIBuilder {
+
+	dp, err := ParseDerivationPath(path)
+
+	if err != nil && b.err == nil {
+		b.err = err
+	}
+
+	return b.Junction(dp...)
+}
+
+// Hard appends a hard junction of the path part, where a numeric part is
+// encoded as an index
+func (b *SecretURIBuilder) Hard(part string) *SecretURIBuilder {
+	return b.junction("//" + part)
+}
+
+// Soft appends a soft junction of the path part, where a numeric part is
+// encoded as an index
+func (b *SecretURIBuilder) Soft(part string) *SecretURIBuilder {
+	return b.junction("/" + part)
+}
+
+// junction parses and appends the junction, recording the first error
+func (b *SecretURIBuilder) junction(str string) *SecretURIBuilder {
+
+	j, err := ParseJunction(str)
+
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+
+		return b
+	}
+
+	return b.Junction(j)
+}
+
+// Password sets the password of the Secret URI
+func (b *SecretURIBuilder) Password(password string) *SecretURIBuilder {
+	b.password = password
+	return b
+}
+
+// Build returns the assembled SecretURI.  An error is returned if any
+// junction was invalid, or the components can not be represented as a Secret
+// URI string which parses back to the same components
+func (b *SecretURIBuilder) Build() (*SecretURI, error) {
+
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	suri := &SecretURI{
+		Phrase:   b.phrase,
+		Path:     b.path.String(),
+		Password: b.password,
+		Network:  b.net,
+	}
+
+	// junctions created from chain codes or numeric strings format to a path
+	// which parses to a different junction
+	dp, err := ParseDerivationPath(suri.Path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, j := range dp {
+		if j.chainCode != b.path[i].chainCode || j.hard != b.path[i].hard {
+			return nil, ErrJunctionNotPath
+		}
+	}
+
+	parsed, err := NewSecretURI(suri.String(), b.net)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if parsed.Phrase != suri.Phrase || parsed.Path != suri.Path || parsed.Password != suri.Password {
+		return nil, ErrInvalidSURIFormat
+	}
+
+	return suri, nil
+}
+
 // DerivableKey returns a DerivableKey from the Secret URI
 func (s *SecretURI) DerivableKey() (sr25519.DerivableKey, DerivablePrivateKey, error) {
 
@@ -119,7 +280,16 @@ func (s *SecretURI) DerivableKey() (sr25519.DerivableKey, DerivablePrivateKey, e
 	} else {
 		// mnemonic word list
 		s.Type = Mnemonic
-		ms, err := sr25519.MiniSecretKeyFromMnemonic(s.Phrase, s.Password)
+		seed, err := mnemonicSeed(s.Phrase, s.Password)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		var raw [32]byte
+		copy(raw[:], seed[:32])
+
+		ms, err := sr25519.NewMiniSecretKeyFromRaw(raw)
 
 		if err != nil {
 			return nil, false, err
@@ -129,10 +299,48 @@ func (s *SecretURI) DerivableKey() (sr25519.DerivableKey, DerivablePrivateKey, e
 	}
 }
 
+// SecretSeed returns the 32 byte secret seed from a Secret URI phrase given
+// as a hex encoded seed or mnemonic.  Used by key schemes such as ed25519
+// which are created directly from a seed rather than a sr25519 MiniSecretKey
+func (s *SecretURI) SecretSeed() ([32]byte, error) {
+	var seed [32]byte
+
+	if b, ok := DecodeHex(s.Phrase, s.Network.AddressPrefix()); ok {
+		// hex encoded secret
+		s.Type = SecretHex
+
+		if len(b) != MiniSecretKeyLength {
+			return seed, ErrInvalidByteLength
+		}
+
+		copy(seed[:], b)
+		return seed, nil
+	}
+
+	// mnemonic word list
+	s.Type = Mnemonic
+	raw, err := mnemonicSeed(s.Phrase, s.Password)
+
+	if err != nil {
+		return seed, err
+	}
+
+	copy(seed[:], raw[:32])
+	return seed, nil
+}
+
+// normalizeMnemonic returns the mnemonic phrase NFKD normalized as required
+// by BIP39 for wordlist lookup, with words separated by a single space so phrases
+// using other whitespace such as the ideographic space are supported.  The
+// password is not normalized as Substrate uses its bytes as given
+func normalizeMnemonic(phrase string) string {
+	return strings.Join(strings.Fields(norm.NFKD.String(phrase)), " ")
+}
+
 // GetJunctions returns the junction parts of the path component of the Secret
 // URI.
-func (s *SecretURI) GetJunctions() ([]*junction, error) {
-	data := make([]*junction, 0)
+func (s *SecretURI) GetJunctions() ([]*Junction, error) {
+	data := make([]*Junction, 0)
 
 	for _, part := range s.pathParts() {
 		jun, err := newJunction(part)
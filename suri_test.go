@@ -1,6 +1,7 @@
 package srkeyring
 
 import (
+	"encoding/hex"
 	"reflect"
 	"testing"
 )
@@ -52,12 +53,12 @@ func TestGetJunctions(t *testing.T) {
 	tests := []struct {
 		name     string
 		path     string
-		expected []*junction
+		expected []*Junction
 	}{
 		{
 			name: "Path Hard",
 			path: "//joe//polkadot//0",
-			expected: []*junction{
+			expected: []*Junction{
 				{
 					path:      "joe",
 					chainCode: [32]byte{12, 106, 111, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
@@ -78,7 +79,7 @@ func TestGetJunctions(t *testing.T) {
 		{
 			name: "Path Soft",
 			path: "/joe/polkadot/0",
-			expected: []*junction{
+			expected: []*Junction{
 				{
 					path:      "joe",
 					chainCode: [32]byte{12, 106, 111, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
@@ -99,7 +100,7 @@ func TestGetJunctions(t *testing.T) {
 		{
 			name: "Path Mixed",
 			path: "//joe/account/1",
-			expected: []*junction{
+			expected: []*Junction{
 				{
 					path:      "joe",
 					chainCode: [32]byte{12, 106, 111, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
@@ -253,3 +254,328 @@ func TestNewSecretURI(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretURIString(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		suri     string
+		redacted string
+	}{
+		{
+			name:     "Mnemonic",
+			suri:     devPhrase,
+			redacted: "***",
+		},
+		{
+			name:     "Mnemonic Path Password",
+			suri:     devPhrase + "//polkadot/0///pass1234",
+			redacted: "***//polkadot/0///***",
+		},
+		{
+			name:     "Secret Hex",
+			suri:     "0xe5be9a5092b81bca64be81d212e7f2f9eba183bb7a90954f7b76361f6edb5c0a//Alice",
+			redacted: "***//Alice",
+		},
+		{
+			name:     "SS58 Address",
+			suri:     "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY/joe",
+			redacted: "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY/joe",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			suri, err := NewSecretURI(tt.suri, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error parsing Secret URI: %v", err)
+			}
+
+			if suri.String() != tt.suri {
+				t.Errorf("Invalid Secret URI, expected %v, got %v", tt.suri, suri.String())
+			}
+
+			if suri.Redacted() != tt.redacted {
+				t.Errorf("Invalid redacted Secret URI, expected %v, got %v", tt.redacted, suri.Redacted())
+			}
+		})
+	}
+}
+
+func TestSecretURIBuilder(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		builder  *SecretURIBuilder
+		expected string
+		err      error
+	}{
+		{
+			name:     "Phrase Only",
+			builder:  NewSecretURIBuilder(devPhrase, NetSubstrate{}),
+			expected: devPhrase,
+		},
+		{
+			name: "Hard Soft Password",
+			builder: NewSecretURIBuilder(devPhrase, NetSubstrate{}).
+				Hard("polkadot").Soft("0").Password("pass1234"),
+			expected: devPhrase + "//polkadot/0///pass1234",
+		},
+		{
+			name: "Typed Junctions",
+			builder: NewSecretURIBuilder(devPhrase, NetSubstrate{}).
+				Junction(NewIndexJunction(1, true)).Path("//joe/account"),
+			expected: devPhrase + "//1//joe/account",
+		},
+		{
+			name: "Chain Code Junction",
+			builder: NewSecretURIBuilder(devPhrase, NetSubstrate{}).
+				Junction(NewChainCodeJunction([32]byte{1}, true)),
+			err: ErrJunctionNotPath,
+		},
+		{
+			name: "Invalid Junction",
+			builder: NewSecretURIBuilder(devPhrase, NetSubstrate{}).
+				Hard("joe/0"),
+			err: ErrInvalidJunction,
+		},
+		{
+			name: "Invalid Path",
+			builder: NewSecretURIBuilder(devPhrase, NetSubstrate{}).
+				Path("joe"),
+			err: ErrInvalidDerivePath,
+		},
+		{
+			name:    "Empty Phrase",
+			builder: NewSecretURIBuilder("", NetSubstrate{}).Hard("joe"),
+			err:     ErrEmptyPhrase,
+		},
+		{
+			name:    "Invalid Phrase",
+			builder: NewSecretURIBuilder("bottom/drive", NetSubstrate{}),
+			err:     ErrInvalidSURIFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			suri, err := tt.builder.Build()
+
+			if err != tt.err {
+				t.Fatalf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if suri.String() != tt.expected {
+				t.Errorf("Invalid Secret URI, expected %v, got %v", tt.expected, suri.String())
+			}
+
+			// built Secret URI creates the same keyring as the string
+			kr, err := FromURI(suri.String(), NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error creating keyring: %v", err)
+			}
+
+			expected, err := FromURI(tt.expected, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error creating keyring: %v", err)
+			}
+
+			if kr.Public() != expected.Public() {
+				t.Errorf("Invalid public key, expected %x, got %x", expected.Public(), kr.Public())
+			}
+		})
+	}
+}
+
+func TestNewSecretURIUnicode(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		suri     string
+		phrase   string
+		path     string
+		password string
+	}{
+		{
+			name:   "Unicode Hard Junction",
+			suri:   devPhrase + "//ウォレット",
+			phrase: devPhrase,
+			path:   "//ウォレット",
+		},
+		{
+			name:     "Unicode Phrase Path Password",
+			suri:     "あいこくしん　あいこくしん//財布/ñandú///contraseña",
+			phrase:   "あいこくしん　あいこくしん",
+			path:     "//財布/ñandú",
+			password: "contraseña",
+		},
+		{
+			name:   "Accented Phrase",
+			suri:   "ábaco abdomen abeja//0",
+			phrase: "ábaco abdomen abeja",
+			path:   "//0",
+		},
+		{
+			name:   "Special Characters",
+			suri:   "phrase with 'quotes', commas & more!/joe's-account",
+			phrase: "phrase with 'quotes', commas & more!",
+			path:   "/joe's-account",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			suri, err := NewSecretURI(tt.suri, NetSubstrate{})
+
+			if err != nil {
+				t.Fatalf("Error parsing Secret URI: %v", err)
+			}
+
+			if suri.Phrase != tt.phrase || suri.Path != tt.path || suri.Password != tt.password {
+				t.Errorf("Invalid Secret URI components, expected %q %q %q, got %q %q %q",
+					tt.phrase, tt.path, tt.password, suri.Phrase, suri.Path, suri.Password)
+			}
+
+			if suri.String() != tt.suri {
+				t.Errorf("Invalid Secret URI, expected %v, got %v", tt.suri, suri.String())
+			}
+		})
+	}
+}
+
+func TestUnicodeMnemonicSubkey(t *testing.T) {
+
+	// subkey only accepts English mnemonics, however as the key is created
+	// from the mnemonic entropy the Japanese and Spanish mnemonics of the
+	// development phrase entropy must derive subkey's //Alice keys.  The
+	// Japanese mnemonic is given NFC composed so is NFKD normalized before use
+	japanese := "えいが　けしょう　ちんもく　そいね　きぼう　ぴっちり　うくらいな　じんじゃ　にくしみ　たいいん　こんれい　らしんばん"
+	spanish := "baba diario nasal lector colgar ron arruga himno pera lomo fiable veinte"
+
+	tests := []struct {
+		name   string
+		suri   string
+		scheme Scheme
+		public string
+		ss58   string
+	}{
+		{
+			name:   "Japanese Sr25519",
+			suri:   japanese + "//Alice",
+			scheme: Sr25519,
+			public: aliceSr25519,
+			ss58:   "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+		},
+		{
+			name:   "Spanish Sr25519",
+			suri:   spanish + "//Alice",
+			scheme: Sr25519,
+			public: aliceSr25519,
+			ss58:   "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+		},
+		{
+			name:   "Japanese Ed25519",
+			suri:   japanese + "//Alice",
+			scheme: Ed25519,
+			public: aliceEd25519,
+			ss58:   "5FA9nQDVg267DEd8m1ZypXLBnvN7SFxYwV7ndqSYGiN9TTpu",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kp, err := FromURIWithScheme(tt.suri, NetSubstrate{}, tt.scheme)
+
+			if err != nil {
+				t.Fatalf("Error creating key pair: %v", err)
+			}
+
+			if pub := hex.EncodeToString(kp.PublicKey()); pub != tt.public {
+				t.Errorf("Invalid public key, expected %v, got %v", tt.public, pub)
+			}
+
+			ss58, err := kp.SS58Address()
+
+			if err != nil {
+				t.Fatalf("Error getting SS58 Address: %v", err)
+			}
+
+			if ss58 != tt.ss58 {
+				t.Errorf("Invalid SS58 Address, expected %v, got %v", tt.ss58, ss58)
+			}
+		})
+	}
+}
+
+func TestNormalizeMnemonic(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		phrase   string
+		expected string
+	}{
+		{
+			name:     "Unchanged",
+			phrase:   devPhrase,
+			expected: devPhrase,
+		},
+		{
+			name:     "Extra Whitespace",
+			phrase:   "  bottom  drive\tobey lake curtain smoke basket hold race lonely fit walk ",
+			expected: devPhrase,
+		},
+		{
+			name:     "Ideographic Space",
+			phrase:   "あいこくしん　あいこくしん",
+			expected: "あいこくしん あいこくしん",
+		},
+		{
+			name: "Composed Accent",
+			// á as the single code point U+00E1
+			phrase: "\u00e1baco",
+			// decomposed into a followed by the combining acute accent U+0301
+			expected: "a\u0301baco",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if res := normalizeMnemonic(tt.phrase); res != tt.expected {
+				t.Errorf("Invalid normalized phrase, expected %q, got %q", tt.expected, res)
+			}
+		})
+	}
+
+	// a phrase with irregular whitespace creates the same keys
+	kr, err := FromURI("bottom  drive obey lake curtain smoke basket hold race lonely fit walk//Alice", NetSubstrate{})
+
+	if err != nil {
+		t.Fatalf("Error creating keyring: %v", err)
+	}
+
+	if EncodeHex(kr.PublicKey(), "") != aliceSr25519 {
+		t.Errorf("Invalid public key, expected %v, got %v", aliceSr25519, kr.PublicHex())
+	}
+}
@@ -0,0 +1,228 @@
+package srkeyring
+
+import (
+	"sort"
+)
+
+const (
+	// maxSuggestions is the maximum number of suggested words returned for an
+	// unknown mnemonic word
+	maxSuggestions = 3
+
+	// maxSuggestionDistance is the maximum edit distance of a suggested word
+	// from an unknown mnemonic word
+	maxSuggestionDistance = 2
+)
+
+// UnknownWord is a mnemonic word not found in the wordlist
+type UnknownWord struct {
+	// Position is the zero based position of the word in the mnemonic
+	Position int
+	// Word is the unknown word NFKD normalized
+	Word string
+	// Suggestions are the closest matching words in the wordlist, ordered by
+	// closeness
+	Suggestions []string
+}
+
+// MnemonicValidation reports the problems found validating a mnemonic
+type MnemonicValidation struct {
+	// Language is the detected language, or when some words are unknown the
+	// language whose wordlist contains the most words
+	Language Language
+	// WordCount is the number of words in the mnemonic
+	WordCount int
+	// ValidWordCount is true when the word count is 12, 15, 18, 21, or 24
+	ValidWordCount bool
+	// UnknownWords are the words not found in the wordlist
+	UnknownWords []UnknownWord
+	// ValidChecksum is true when the BIP39 checksum is valid.  The checksum
+	// can only be checked when the word count is valid and all words are
+	// known, otherwise it is false
+	ValidChecksum bool
+}
+
+// ValidateMnemonic validates the mnemonic, detecting its language, and reports
+// any unknown words with suggestions, an invalid word count, or an invalid
+// checksum
+func ValidateMnemonic(mnemonic string) *MnemonicValidation {
+
+	lang, err := DetectLanguage(mnemonic)
+
+	if err != nil {
+		lang = closestLanguage(mnemonicWords(mnemonic))
+	}
+
+	// the language is known to be valid so no error is returned
+	v, _ := ValidateMnemonicWithLanguage(mnemonic, lang)
+
+	return v
+}
+
+// ValidateMnemonicWithLanguage validates the mnemonic against the wordlist of
+// the given language
+func ValidateMnemonicWithLanguage(mnemonic string, lang Language) (*MnemonicValidation, error) {
+
+	wl, err := getWordlist(lang)
+
+	if err != nil {
+		return nil, err
+	}
+
+	words := mnemonicWords(mnemonic)
+	_, validCount := entropyWords[WordCount(len(words))]
+
+	v := &MnemonicValidation{
+		Language:       lang,
+		WordCount:      len(words),
+		ValidWordCount: validCount,
+	}
+
+	for i, w := range words {
+		if _, ok := wl.index[w]; !ok {
+			v.UnknownWords = append(v.UnknownWords, UnknownWord{
+				Position:    i,
+				Word:        w,
+				Suggestions: wl.suggest(w),
+			})
+		}
+	}
+
+	if v.ValidWordCount && len(v.UnknownWords) == 0 {
+		_, err := mnemonicToEntropy(mnemonic, lang)
+		v.ValidChecksum = err == nil
+	}
+
+	return v, nil
+}
+
+// Valid returns true if the mnemonic has no problems
+func (v *MnemonicValidation) Valid() bool {
+	return v.ValidWordCount && len(v.UnknownWords) == 0 && v.ValidChecksum
+}
+
+// Err returns the error of the first problem found, or nil if the mnemonic is
+// valid
+func (v *MnemonicValidation) Err() error {
+	switch {
+	case len(v.UnknownWords) > 0:
+		return ErrUnknownWord
+	case !v.ValidWordCount:
+		return ErrInvalidWordCount
+	case !v.ValidChecksum:
+		return ErrInvalidChecksum
+	default:
+		return nil
+	}
+}
+
+// closestLanguage returns the language whose wordlist contains the most
+// words, preferring the earlier language in languages when tied
+func closestLanguage(words []string) Language {
+
+	best, bestCount := English, -1
+
+	for _, lang := range languages {
+		wl, err := getWordlist(lang)
+
+		if err != nil {
+			continue
+		}
+
+		count := 0
+
+		for _, w := range words {
+			if _, ok := wl.index[w]; ok {
+				count++
+			}
+		}
+
+		if count > bestCount {
+			best, bestCount = lang, count
+		}
+	}
+
+	return best
+}
+
+// suggest returns the wordlist words closest to the unknown word by edit
+// distance
+func (wl *wordlist) suggest(word string) []string {
+
+	type candidate struct {
+		word     string
+		distance int
+	}
+
+	var candidates []candidate
+
+	for w := range wl.index {
+		if d := editDistance(word, w); d <= maxSuggestionDistance {
+			candidates = append(candidates, candidate{word: w, distance: d})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+
+		return wl.index[candidates[i].word] < wl.index[candidates[j].word]
+	})
+
+	if len(candidates) > maxSuggestions {
+		candidates = candidates[:maxSuggestions]
+	}
+
+	res := make([]string, 0, len(candidates))
+
+	for _, c := range candidates {
+		res = append(res, wl.words[wl.index[c.word]])
+	}
+
+	return res
+}
+
+// editDistance returns the Levenshtein distance between the strings counted
+// in runes
+func editDistance(a, b string) int {
+
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// min3 returns the smallest of the three integers
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+
+	if c < a {
+		a = c
+	}
+
+	return a
+}
@@ -0,0 +1,147 @@
+package srkeyring
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateMnemonic(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		mnemonic  string
+		lang      Language
+		wordCount int
+		validWC   bool
+		unknown   []UnknownWord
+		checksum  bool
+		err       error
+	}{
+		{
+			name:      "Valid",
+			mnemonic:  devPhrase,
+			lang:      English,
+			wordCount: 12,
+			validWC:   true,
+			checksum:  true,
+		},
+		{
+			name:      "Valid Japanese",
+			mnemonic:  "あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あおぞら",
+			lang:      Japanese,
+			wordCount: 12,
+			validWC:   true,
+			checksum:  true,
+		},
+		{
+			name:      "Misspelt Words",
+			mnemonic:  "bottom drive obey lake curtain smoke baskett hold race lonly fit walk",
+			lang:      English,
+			wordCount: 12,
+			validWC:   true,
+			unknown: []UnknownWord{
+				{Position: 6, Word: "baskett", Suggestions: []string{"basket"}},
+				{Position: 9, Word: "lonly", Suggestions: []string{"lonely", "only", "honey"}},
+			},
+			err: ErrUnknownWord,
+		},
+		{
+			name:      "Invalid Checksum",
+			mnemonic:  "bottom drive obey lake curtain smoke basket hold race lonely fit fit",
+			lang:      English,
+			wordCount: 12,
+			validWC:   true,
+			err:       ErrInvalidChecksum,
+		},
+		{
+			name:      "Invalid Word Count",
+			mnemonic:  "bottom drive obey lake curtain smoke basket hold race lonely fit",
+			lang:      English,
+			wordCount: 11,
+			err:       ErrInvalidWordCount,
+		},
+		{
+			name:      "No Suggestions",
+			mnemonic:  "bottom drive obey lake curtain smoke basket hold race lonely fit xyzzyq",
+			lang:      English,
+			wordCount: 12,
+			validWC:   true,
+			unknown: []UnknownWord{
+				{Position: 11, Word: "xyzzyq", Suggestions: []string{}},
+			},
+			err: ErrUnknownWord,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			v := ValidateMnemonic(tt.mnemonic)
+
+			if v.Language != tt.lang {
+				t.Errorf("Invalid language, expected %v, got %v", tt.lang, v.Language)
+			}
+
+			if v.WordCount != tt.wordCount || v.ValidWordCount != tt.validWC {
+				t.Errorf("Invalid word count, expected %d %v, got %d %v", tt.wordCount, tt.validWC, v.WordCount, v.ValidWordCount)
+			}
+
+			if !reflect.DeepEqual(v.UnknownWords, tt.unknown) {
+				t.Errorf("Invalid unknown words, expected %+v, got %+v", tt.unknown, v.UnknownWords)
+			}
+
+			if v.ValidChecksum != tt.checksum {
+				t.Errorf("Invalid checksum flag, expected %v, got %v", tt.checksum, v.ValidChecksum)
+			}
+
+			if v.Err() != tt.err {
+				t.Errorf("Invalid error, expected %v, got %v", tt.err, v.Err())
+			}
+
+			if v.Valid() != (tt.err == nil) {
+				t.Errorf("Invalid valid flag, expected %v, got %v", tt.err == nil, v.Valid())
+			}
+		})
+	}
+}
+
+func TestValidateMnemonicWithLanguage(t *testing.T) {
+
+	v, err := ValidateMnemonicWithLanguage(devPhrase, Spanish)
+
+	if err != nil {
+		t.Fatalf("Error validating mnemonic: %v", err)
+	}
+
+	if len(v.UnknownWords) != 12 || v.Err() != ErrUnknownWord {
+		t.Errorf("Expected all words unknown in Spanish, got %d", len(v.UnknownWords))
+	}
+
+	if _, err := ValidateMnemonicWithLanguage(devPhrase, Language(20)); err != ErrUnknownLanguage {
+		t.Errorf("Expected unknown language error, got %v", err)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+
+	tests := []struct {
+		a, b     string
+		distance int
+	}{
+		{"", "", 0},
+		{"basket", "basket", 0},
+		{"baskett", "basket", 1},
+		{"lonly", "lonely", 1},
+		{"kitten", "sitting", 3},
+		{"ñandú", "nandu", 2},
+		{"", "abc", 3},
+	}
+
+	for _, tt := range tests {
+		if d := editDistance(tt.a, tt.b); d != tt.distance {
+			t.Errorf("Invalid distance between %q and %q, expected %d, got %d", tt.a, tt.b, tt.distance, d)
+		}
+	}
+}
@@ -0,0 +1,499 @@
+package srkeyring
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"math"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	sr25519 "github.com/ChainSafe/go-schnorrkel"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// base58Alphabet is the alphabet of characters used in SS58 addresses
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+	// defaultProgressInterval is the interval progress is reported at when
+	// not set
+	defaultProgressInterval = time.Second
+
+	// vanityEntropyBytes is the entropy length of the 12 word mnemonics
+	// generated by a vanity search
+	vanityEntropyBytes = 16
+)
+
+var (
+	ErrEmptyPattern       = errors.New("Vanity pattern is empty")
+	ErrInvalidPattern     = errors.New("Vanity pattern contains characters not used in SS58 addresses")
+	ErrUnknownMatch       = errors.New("Unknown vanity match type")
+	ErrNoDifficulty       = errors.New("Difficulty can not be estimated for a regular expression")
+	ErrPatternUnreachable = errors.New("Vanity pattern is longer than the SS58 address")
+)
+
+// VanityMatch specifies how the vanity pattern is matched against the SS58
+// address
+type VanityMatch int
+
+const (
+	// VanityContains matches addresses containing the pattern
+	VanityContains VanityMatch = iota
+	// VanityPrefix matches addresses starting with the pattern.  The first
+	// characters of an address are determined by the network prefix, eg: all
+	// Substrate addresses start with "5", so should be included in the pattern
+	VanityPrefix
+	// VanityRegex matches addresses using the pattern as a regular expression
+	VanityRegex
+)
+
+// VanityOptions defines the vanity address search
+type VanityOptions struct {
+	// Pattern is the pattern to search for
+	Pattern string
+	// Match is how the pattern is matched
+	Match VanityMatch
+	// IgnoreCase matches the pattern case-insensitively
+	IgnoreCase bool
+	// Scheme is the key scheme, defaults to Sr25519
+	Scheme Scheme
+	// Network is the network of the SS58 address, defaults to NetSubstrate
+	Network Network
+	// Workers is the number of concurrent workers, defaults to the number of
+	// CPUs
+	Workers int
+	// Progress is called periodically with the search progress when set
+	Progress func(VanityProgress)
+	// ProgressInterval is the interval Progress is called at, defaults to
+	// one second
+	ProgressInterval time.Duration
+	// SeedOnly searches random secret seeds instead of mnemonics.  This is
+	// many times faster as the mnemonic key stretching is skipped, however
+	// the key can only be backed up and restored from the hex encoded seed
+	SeedOnly bool
+}
+
+// VanityProgress reports the progress of a vanity search
+type VanityProgress struct {
+	// Attempts is the number of keys generated so far
+	Attempts uint64
+	// Elapsed is the time since the search started
+	Elapsed time.Duration
+	// Rate is the number of attempts per second
+	Rate float64
+}
+
+// VanityResult is the key found by a vanity search
+type VanityResult struct {
+	// KeyPair is the key pair with the matching address
+	KeyPair KeyPair
+	// Mnemonic is the 12 word English mnemonic of the key pair, empty when
+	// searching with SeedOnly
+	Mnemonic string
+	// SURI is the Secret URI of the key pair, being the mnemonic or the hex
+	// encoded secret seed when searching with SeedOnly
+	SURI string
+	// Address is the matching SS58 address
+	Address string
+	// Attempts is the number of keys generated during the search
+	Attempts uint64
+}
+
+// withDefaults returns the options with unset values set to their defaults
+func (o VanityOptions) withDefaults() VanityOptions {
+
+	if o.Scheme == 0 {
+		o.Scheme = Sr25519
+	}
+
+	if o.Network == nil {
+		o.Network = NetSubstrate{}
+	}
+
+	if o.Workers <= 0 {
+		o.Workers = runtime.NumCPU()
+	}
+
+	if o.ProgressInterval <= 0 {
+		o.ProgressInterval = defaultProgressInterval
+	}
+
+	return o
+}
+
+// matcher returns the function matching an address against the pattern
+func (o VanityOptions) matcher() (func(string) bool, error) {
+
+	if o.Pattern == "" {
+		return nil, ErrEmptyPattern
+	}
+
+	if o.Match == VanityRegex {
+		expr := o.Pattern
+
+		if o.IgnoreCase {
+			expr = "(?i)" + expr
+		}
+
+		re, err := regexp.Compile(expr)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return re.MatchString, nil
+	}
+
+	if _, err := charProbabilities(o.Pattern, o.IgnoreCase); err != nil {
+		return nil, err
+	}
+
+	pattern := o.Pattern
+
+	if o.IgnoreCase {
+		pattern = strings.ToLower(pattern)
+	}
+
+	var match func(s, substr string) bool
+
+	switch o.Match {
+	case VanityContains:
+		match = strings.Contains
+	case VanityPrefix:
+		match = strings.HasPrefix
+	default:
+		return nil, ErrUnknownMatch
+	}
+
+	return func(addr string) bool {
+		if o.IgnoreCase {
+			addr = strings.ToLower(addr)
+		}
+
+		return match(addr, pattern)
+	}, nil
+}
+
+// charProbabilities returns the probability of each pattern character
+// occurring at a position in an address, assuming a uniform distribution over
+// the base58 alphabet
+func charProbabilities(pattern string, ignoreCase bool) ([]float64, error) {
+
+	probs := make([]float64, 0, len(pattern))
+
+	for _, c := range pattern {
+
+		n := 0
+
+		if ignoreCase {
+			for _, v := range []string{strings.ToLower(string(c)), strings.ToUpper(string(c))} {
+				if strings.Contains(base58Alphabet, v) {
+					n++
+				}
+			}
+
+			if strings.ToLower(string(c)) == strings.ToUpper(string(c)) && n > 0 {
+				// digits have no case
+				n = 1
+			}
+
+		} else if strings.ContainsRune(base58Alphabet, c) {
+			n = 1
+		}
+
+		if n == 0 {
+			return nil, ErrInvalidPattern
+		}
+
+		probs = append(probs, float64(n)/float64(len(base58Alphabet)))
+	}
+
+	return probs, nil
+}
+
+// Difficulty returns the estimated number of attempts needed to find a
+// matching address.  The estimate assumes address characters are uniformly
+// distributed so is approximate, particularly for the leading characters
+// determined by the network prefix
+func (o VanityOptions) Difficulty() (float64, error) {
+
+	o = o.withDefaults()
+
+	if o.Pattern == "" {
+		return 0, ErrEmptyPattern
+	}
+
+	if o.Match == VanityRegex {
+		return 0, ErrNoDifficulty
+	}
+
+	probs, err := charProbabilities(o.Pattern, o.IgnoreCase)
+
+	if err != nil {
+		return 0, err
+	}
+
+	// probability of the pattern matching at a given position
+	p := 1.0
+
+	for _, cp := range probs {
+		p *= cp
+	}
+
+	addr, err := SS58Address([32]byte{}, o.Network, SS58Checksum)
+
+	if err != nil {
+		return 0, err
+	}
+
+	positions := len(addr) - len(probs) + 1
+
+	if positions < 1 {
+		return 0, ErrPatternUnreachable
+	}
+
+	switch o.Match {
+	case VanityPrefix:
+		return 1 / p, nil
+
+	case VanityContains:
+		// probability of at least one position matching
+		return 1 / (1 - math.Pow(1-p, float64(positions))), nil
+
+	default:
+		return 0, ErrUnknownMatch
+	}
+}
+
+// Vanity searches for a key pair with an SS58 address matching the pattern
+// using concurrent workers, until one is found or the context is cancelled.
+// Each attempt generates a random 12 word mnemonic, as done by Generate, so
+// the key can be backed up and restored from its mnemonic.  Set SeedOnly to
+// search faster using random secret seeds which have no mnemonic
+func Vanity(ctx context.Context, opts VanityOptions) (*VanityResult, error) {
+
+	opts = opts.withDefaults()
+
+	match, err := opts.matcher()
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch opts.Scheme {
+	case Sr25519, Ed25519, Ecdsa:
+	default:
+		return nil, ErrUnknownScheme
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var attempts uint64
+	var once sync.Once
+	var wg sync.WaitGroup
+	var found vanityKey
+	var foundAddr string
+	errs := make(chan error, opts.Workers)
+
+	for i := 0; i < opts.Workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			key, addr, err := vanityWorker(ctx, opts, match, &attempts)
+
+			if err != nil {
+				errs <- err
+				cancel()
+				return
+			}
+
+			if addr != "" {
+				once.Do(func() {
+					found = key
+					foundAddr = addr
+				})
+				cancel()
+			}
+		}()
+	}
+
+	if opts.Progress != nil {
+		start := time.Now()
+		done := make(chan struct{})
+		defer close(done)
+
+		go func() {
+			ticker := time.NewTicker(opts.ProgressInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-done:
+					return
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					n := atomic.LoadUint64(&attempts)
+					elapsed := time.Since(start)
+
+					opts.Progress(VanityProgress{
+						Attempts: n,
+						Elapsed:  elapsed,
+						Rate:     float64(n) / elapsed.Seconds(),
+					})
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	if foundAddr == "" {
+		if err, ok := <-errs; ok {
+			return nil, err
+		}
+
+		return nil, ctx.Err()
+	}
+
+	res := &VanityResult{
+		SURI: EncodeHex(found.seed[:], "0x"),
+	}
+
+	if found.entropy != nil {
+		res.Mnemonic, err = entropyToMnemonic(found.entropy, English)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.SURI = res.Mnemonic
+	}
+
+	kp, err := FromURIWithScheme(res.SURI, opts.Network, opts.Scheme)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res.KeyPair = kp
+	res.Address = foundAddr
+	res.Attempts = atomic.LoadUint64(&attempts)
+
+	return res, nil
+}
+
+// vanityKey is a key generated by a vanity search
+type vanityKey struct {
+	// seed is the secret seed of the key
+	seed [32]byte
+	// entropy is the mnemonic entropy the seed was created from, nil when
+	// searching with SeedOnly
+	entropy []byte
+}
+
+// vanityWorker generates keys until an address matches or the context is
+// cancelled.  Keys are created from random mnemonic entropy, or when
+// searching with SeedOnly from a random seed incremented on each attempt
+func vanityWorker(ctx context.Context, opts VanityOptions, match func(string) bool,
+	attempts *uint64) (vanityKey, string, error) {
+
+	var key vanityKey
+
+	if opts.SeedOnly {
+		if _, err := rand.Read(key.seed[:]); err != nil {
+			return key, "", err
+		}
+	} else {
+		key.entropy = make([]byte, vanityEntropyBytes)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return key, "", nil
+		default:
+		}
+
+		atomic.AddUint64(attempts, 1)
+
+		if !opts.SeedOnly {
+			if _, err := rand.Read(key.entropy); err != nil {
+				return key, "", err
+			}
+
+			seed := entropySeed(key.entropy, "")
+			copy(key.seed[:], seed[:32])
+		}
+
+		acc, ok := vanityAccountID(key.seed, opts.Scheme)
+
+		if ok {
+			addr, err := SS58Address(acc, opts.Network, SS58Checksum)
+
+			if err != nil {
+				return key, "", err
+			}
+
+			if match(addr) {
+				return key, addr, nil
+			}
+		}
+
+		if opts.SeedOnly {
+			incrementSeed(&key.seed)
+		}
+	}
+}
+
+// vanityAccountID returns the account id of the key created from the secret
+// seed, without the overhead of parsing a Secret URI
+func vanityAccountID(seed [32]byte, scheme Scheme) ([32]byte, bool) {
+	var acc [32]byte
+
+	switch scheme {
+	case Ed25519:
+		copy(acc[:], ed25519.NewKeyFromSeed(seed[:]).Public().(ed25519.PublicKey))
+
+	case Ecdsa:
+		secret, err := newEcdsaSecret(seed)
+
+		if err != nil {
+			return acc, false
+		}
+
+		acc = blake2b.Sum256(secret.PubKey().SerializeCompressed())
+
+	default:
+		ms, err := sr25519.NewMiniSecretKeyFromRaw(seed)
+
+		if err != nil {
+			return acc, false
+		}
+
+		acc = ms.Public().Encode()
+	}
+
+	return acc, true
+}
+
+// incrementSeed increments the seed as a little endian integer
+func incrementSeed(seed *[32]byte) {
+	for i := range seed {
+		seed[i]++
+
+		if seed[i] != 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package srkeyring
+
+import (
+	"context"
+	"math"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestVanity(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		opts  VanityOptions
+		match func(addr string) bool
+	}{
+		{
+			name: "Contains Sr25519",
+			opts: VanityOptions{Pattern: "ab"},
+			match: func(addr string) bool {
+				return strings.Contains(addr, "ab")
+			},
+		},
+		{
+			name: "Prefix Ed25519",
+			opts: VanityOptions{Pattern: "5F", Match: VanityPrefix, Scheme: Ed25519},
+			match: func(addr string) bool {
+				return strings.HasPrefix(addr, "5F")
+			},
+		},
+		{
+			name: "Prefix Ignore Case Ecdsa",
+			opts: VanityOptions{Pattern: "5c", Match: VanityPrefix, IgnoreCase: true, Scheme: Ecdsa},
+			match: func(addr string) bool {
+				return strings.HasPrefix(addr, "5C") || strings.HasPrefix(addr, "5c")
+			},
+		},
+		{
+			name:  "Regex Polkadot",
+			opts:  VanityOptions{Pattern: "^1[0-9]", Match: VanityRegex, Network: netWide{prefix: 0}},
+			match: regexp.MustCompile("^1[0-9]").MatchString,
+		},
+		{
+			name: "Seed Only Sr25519",
+			opts: VanityOptions{Pattern: "ab", SeedOnly: true},
+			match: func(addr string) bool {
+				return strings.Contains(addr, "ab")
+			},
+		},
+		{
+			name: "Seed Only Ed25519",
+			opts: VanityOptions{Pattern: "5F", Match: VanityPrefix, Scheme: Ed25519, SeedOnly: true},
+			match: func(addr string) bool {
+				return strings.HasPrefix(addr, "5F")
+			},
+		},
+		{
+			name: "Single Worker",
+			opts: VanityOptions{Pattern: "z", Workers: 1},
+			match: func(addr string) bool {
+				return strings.Contains(addr, "z")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := Vanity(context.Background(), tt.opts)
+
+			if err != nil {
+				t.Fatalf("Error searching vanity address: %v", err)
+			}
+
+			if !tt.match(res.Address) {
+				t.Errorf("Address %v does not match pattern %v", res.Address, tt.opts.Pattern)
+			}
+
+			if res.Attempts == 0 {
+				t.Errorf("Expected attempts to be counted")
+			}
+
+			opts := tt.opts.withDefaults()
+
+			if opts.SeedOnly {
+				if res.Mnemonic != "" || !strings.HasPrefix(res.SURI, "0x") {
+					t.Errorf("Expected seed only result, got mnemonic %q and SURI %v", res.Mnemonic, res.SURI)
+				}
+
+			} else {
+				if res.SURI != res.Mnemonic {
+					t.Errorf("Invalid SURI, expected mnemonic %v, got %v", res.Mnemonic, res.SURI)
+				}
+
+				if v := ValidateMnemonic(res.Mnemonic); !v.Valid() || v.WordCount != 12 {
+					t.Errorf("Invalid mnemonic %q: %v", res.Mnemonic, v.Err())
+				}
+			}
+
+			if res.KeyPair.Scheme() != opts.Scheme {
+				t.Errorf("Invalid scheme, expected %v, got %v", opts.Scheme, res.KeyPair.Scheme())
+			}
+
+			// the key pair recreated from the SURI has the matching address
+			kp, err := FromURIWithScheme(res.SURI, opts.Network, opts.Scheme)
+
+			if err != nil {
+				t.Fatalf("Error generating key pair: %v", err)
+			}
+
+			ss58, err := kp.SS58Address()
+
+			if err != nil {
+				t.Fatalf("Error getting SS58 Address: %v", err)
+			}
+
+			if ss58 != res.Address {
+				t.Errorf("Invalid address, expected %v, got %v", res.Address, ss58)
+			}
+		})
+	}
+}
+
+func TestVanityCancel(t *testing.T) {
+
+	var calls int32
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	opts := VanityOptions{
+		Pattern:          "zzzzzzzzzzzz",
+		ProgressInterval: 20 * time.Millisecond,
+		Progress: func(p VanityProgress) {
+			atomic.AddInt32(&calls, 1)
+
+			if p.Attempts > 0 && p.Rate <= 0 {
+				t.Errorf("Invalid rate %v for %d attempts", p.Rate, p.Attempts)
+			}
+		},
+	}
+
+	_, err := Vanity(ctx, opts)
+
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected deadline exceeded error, got %v", err)
+	}
+
+	if atomic.LoadInt32(&calls) == 0 {
+		t.Errorf("Expected progress to be reported")
+	}
+}
+
+func TestVanityInvalid(t *testing.T) {
+
+	tests := []struct {
+		name string
+		opts VanityOptions
+		err  error
+	}{
+		{
+			name: "Empty Pattern",
+			opts: VanityOptions{},
+			err:  ErrEmptyPattern,
+		},
+		{
+			name: "Not Base58",
+			opts: VanityOptions{Pattern: "0x"},
+			err:  ErrInvalidPattern,
+		},
+		{
+			name: "Not Base58 Case Sensitive",
+			opts: VanityOptions{Pattern: "l"},
+			err:  ErrInvalidPattern,
+		},
+		{
+			name: "Unknown Match",
+			opts: VanityOptions{Pattern: "a", Match: VanityMatch(10)},
+			err:  ErrUnknownMatch,
+		},
+		{
+			name: "Unknown Scheme",
+			opts: VanityOptions{Pattern: "a", Scheme: Scheme(10)},
+			err:  ErrUnknownScheme,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := Vanity(context.Background(), tt.opts); err != tt.err {
+				t.Errorf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestVanityDifficulty(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		opts       VanityOptions
+		difficulty float64
+		err        error
+	}{
+		{
+			name:       "Prefix",
+			opts:       VanityOptions{Pattern: "5ab", Match: VanityPrefix},
+			difficulty: 58 * 58 * 58,
+		},
+		{
+			name:       "Prefix Ignore Case",
+			opts:       VanityOptions{Pattern: "5ab", Match: VanityPrefix, IgnoreCase: true},
+			difficulty: 58 * 29 * 29,
+		},
+		{
+			name:       "Prefix Ignore Case Single Case Letter",
+			opts:       VanityOptions{Pattern: "l", Match: VanityPrefix, IgnoreCase: true},
+			difficulty: 58,
+		},
+		{
+			name: "Contains",
+			opts: VanityOptions{Pattern: "ab"},
+			// 48 character address gives 47 positions
+			difficulty: 1 / (1 - math.Pow(1-1.0/(58*58), 47)),
+		},
+		{
+			name: "Regex",
+			opts: VanityOptions{Pattern: "^5", Match: VanityRegex},
+			err:  ErrNoDifficulty,
+		},
+		{
+			name: "Too Long",
+			opts: VanityOptions{Pattern: strings.Repeat("a", 49)},
+			err:  ErrPatternUnreachable,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := tt.opts.Difficulty()
+
+			if err != tt.err {
+				t.Fatalf("Invalid error, expected %v, got %v", tt.err, err)
+			}
+
+			if math.Abs(d-tt.difficulty) > 1e-6*tt.difficulty {
+				t.Errorf("Invalid difficulty, expected %v, got %v", tt.difficulty, d)
+			}
+		})
+	}
+}
+
+func TestIncrementSeed(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		seed     [32]byte
+		expected [32]byte
+	}{
+		{
+			name:     "Zero",
+			seed:     [32]byte{},
+			expected: [32]byte{1},
+		},
+		{
+			name:     "Carry",
+			seed:     [32]byte{0xff, 0xff, 0x01},
+			expected: [32]byte{0x00, 0x00, 0x02},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			seed := tt.seed
+			incrementSeed(&seed)
+
+			if seed != tt.expected {
+				t.Errorf("Invalid seed, expected %x, got %x", tt.expected, seed)
+			}
+		})
+	}
+}